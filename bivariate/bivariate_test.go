@@ -175,6 +175,166 @@ outerReduced:
 	}
 }
 
+// randomPolynomial returns a random polynomial over r with the given number of
+// terms (counting zeros), each of total degree at most maxDeg.
+func randomPolynomial(r *QuotientRing, maxDeg uint, nTerms int) *Polynomial {
+	coefs := make(map[[2]uint]ff.Element, nTerms)
+	for i := 0; i < nTerms; i++ {
+		a := uint(prg.Intn(int(maxDeg) + 1))
+		b := uint(prg.Intn(int(maxDeg-a) + 1))
+		coefs[[2]uint{a, b}] = r.baseField.RandElement()
+	}
+	return r.Polynomial(coefs)
+}
+
+// equalGenerators determines whether the generators of id1 and id2 coincide up
+// to permutation.
+func equalGenerators(id1, id2 *Ideal) bool {
+	if len(id1.generators) != len(id2.generators) {
+		return false
+	}
+outer:
+	for _, g := range id1.generators {
+		for _, h := range id2.generators {
+			if g.Equal(h) {
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func TestGroebnerCriteria(t *testing.T) {
+	fieldLoop(func(field ff.Field) {
+		for _, ord := range []Order{Lex(true), DegRevLex(false), WDegLex(2, 3, true)} {
+			r := DefRing(field, ord)
+
+			// Define many generators of the ideal <f1, f2>
+			f1 := randomPolynomial(r, 4, 5)
+			f2 := randomPolynomial(r, 4, 5)
+			gens := []*Polynomial{f1, f2}
+			for i := 0; i < 30; i++ {
+				gens = append(gens, randomPolynomial(r, 3, 4).Times(f1).Plus(
+					randomPolynomial(r, 3, 4).Times(f2),
+				))
+			}
+			prg.Shuffle(len(gens), func(i, j int) {
+				gens[i], gens[j] = gens[j], gens[i]
+			})
+
+			small, err := r.NewIdeal(f1, f2)
+			if err != nil {
+				// Both generators were zero. Skip this case
+				continue
+			}
+			large, _ := r.NewIdeal(gens...)
+
+			gbSmall := small.GroebnerBasis()
+			gbLarge, stats := large.GroebnerBasisWithStats()
+
+			if stats.Discarded() == 0 {
+				t.Errorf("No pairs were discarded for %v", large)
+			}
+			if stats.Reductions+stats.Discarded() != stats.Pairs {
+				t.Errorf("Statistics %v are inconsistent", stats)
+			}
+
+			gbSmall.ReduceBasis()
+			gbLarge.ReduceBasis()
+
+			// Check the Gröbner basis property without using the criteria
+			check, _ := r.NewIdeal(gbLarge.generators...)
+			if !check.IsGroebner() {
+				t.Errorf("GroebnerBasis did not return a Gröbner basis of %v", large)
+			}
+			if !equalGenerators(gbSmall, gbLarge) {
+				t.Errorf(
+					"Reduced Gröbner bases %v and %v differ",
+					gbSmall.generators, gbLarge.generators,
+				)
+			}
+		}
+	}, 3)
+}
+
+func TestF4(t *testing.T) {
+	fieldLoop(func(field ff.Field) {
+		for _, ord := range []Order{Lex(false), DegLex(true), WDegRevLex(3, 2, true)} {
+			r := DefRing(field, ord)
+
+			id, err := r.NewIdeal(
+				randomPolynomial(r, 4, 6),
+				randomPolynomial(r, 4, 6),
+			)
+			if err != nil {
+				// All generators were zero. Skip this case
+				continue
+			}
+
+			gbBuchberger := id.GroebnerBasis()
+			id.SetGroebnerAlgorithm(F4)
+			gbF4, stats := id.GroebnerBasisWithStats()
+
+			if gbF4.GroebnerAlgorithm() != F4 {
+				t.Errorf("Gröbner basis does not use F4")
+			}
+			if stats.Matrices == 0 && stats.Pairs != stats.Discarded() {
+				t.Errorf("F4 computation did not reduce any matrices")
+			}
+			if stats.Reductions+stats.Discarded() != stats.Pairs {
+				t.Errorf("Statistics %v are inconsistent", stats)
+			}
+
+			gbBuchberger.ReduceBasis()
+			gbF4.ReduceBasis()
+			if !equalGenerators(gbBuchberger, gbF4) {
+				t.Errorf(
+					"Buchberger and F4 gave reduced Gröbner bases %v and %v",
+					gbBuchberger.generators, gbF4.generators,
+				)
+			}
+		}
+	})
+}
+
+func TestF4Hermitian(t *testing.T) {
+	for _, q := range []uint{2, 3, 4} {
+		field := defineField(q*q, t)
+		r := DefRing(field, WDegLex(q, q+1, false))
+
+		// Define the Hermitian curve together with the field equations
+		id, _ := r.NewIdeal(
+			r.PolynomialFromSigned(map[[2]uint]int{
+				{q + 1, 0}: 1,
+				{0, q}:     -1,
+				{0, 1}:     -1,
+			}),
+			r.PolynomialFromSigned(map[[2]uint]int{
+				{q * q, 0}: 1,
+				{1, 0}:     -1,
+			}),
+			r.PolynomialFromSigned(map[[2]uint]int{
+				{0, q * q}: 1,
+				{0, 1}:     -1,
+			}),
+		)
+
+		gbBuchberger := id.GroebnerBasis()
+		id.SetGroebnerAlgorithm(F4)
+		gbF4 := id.GroebnerBasis()
+
+		gbBuchberger.ReduceBasis()
+		gbF4.ReduceBasis()
+		if !equalGenerators(gbBuchberger, gbF4) {
+			t.Errorf(
+				"Buchberger and F4 gave reduced Gröbner bases %v and %v",
+				gbBuchberger.generators, gbF4.generators,
+			)
+		}
+	}
+}
+
 func TestQuotientErrors(t *testing.T) {
 	field1 := defineField(49, t)
 	field2 := defineField(25, t)
@@ -2,6 +2,7 @@ package bivariate_test
 
 import (
 	"fmt"
+	"log"
 
 	"github.com/ReneBoedker/algobra/bivariate"
 	"github.com/ReneBoedker/algobra/finitefield"
@@ -177,3 +178,21 @@ func ExampleQuotientRing_SetVarNames() {
 	// f(S, T) = T^4 + (a + 1)S^3T^2 + a
 	// g(S, T) = ST^3 + T^2 + S
 }
+
+func ExampleIdeal_FGLM() {
+	field, _ := finitefield.Define(7)
+	ring := bivariate.DefRing(field, bivariate.DegRevLex(true))
+	f, _ := ring.PolynomialFromString("X^2 + Y^2 - 2")
+	g, _ := ring.PolynomialFromString("XY - 1")
+	id, _ := ring.NewIdeal(f, g)
+
+	// Convert to the lexicographical ordering
+	lexRing := bivariate.DefRing(field, bivariate.Lex(true))
+	lexId, err := id.FGLM(lexRing)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(lexId.ShortString())
+	// Output:
+	// <Y^4 + 5Y^2 + 1, X + Y^3 + 5Y>
+}
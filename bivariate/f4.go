@@ -0,0 +1,194 @@
+package bivariate
+
+import (
+	"sort"
+
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// GroebnerAlgorithm denotes an algorithm for computing Gröbner bases.
+type GroebnerAlgorithm uint8
+
+// Defined algorithms for computing Gröbner bases.
+const (
+	Buchberger GroebnerAlgorithm = iota // Buchberger's algorithm
+	F4                                  // Faugère's F4 algorithm
+)
+
+// SetGroebnerAlgorithm sets the algorithm used when computing Gröbner bases of
+// id. By default, Buchberger's algorithm is used.
+//
+// The F4 algorithm reduces several S-polynomials at once by row reducing a
+// Macaulay-style matrix. Both algorithms use the same criteria for discarding
+// critical pairs, so the results can be cross-checked.
+func (id *Ideal) SetGroebnerAlgorithm(alg GroebnerAlgorithm) {
+	id.algorithm = alg
+}
+
+// GroebnerAlgorithm returns the algorithm used when computing Gröbner bases of
+// id.
+func (id *Ideal) GroebnerAlgorithm() GroebnerAlgorithm {
+	return id.algorithm
+}
+
+// shiftedCoefs returns the coefficients of X^d[0]*Y^d[1]*f. The result is not
+// reduced modulo the ideal of the ring.
+func shiftedCoefs(f *Polynomial, d [2]uint) map[[2]uint]ff.Element {
+	out := make(map[[2]uint]ff.Element, len(f.coefs))
+	for deg, c := range f.coefs {
+		out[[2]uint{deg[0] + d[0], deg[1] + d[1]}] = c
+	}
+	return out
+}
+
+// f4Step treats all critical pairs of minimal degree simultaneously, following
+// [Fau99; Section 2]. The S-polynomials are represented as rows of a matrix
+// whose columns are indexed by monomials. After adding rows for reducing the
+// monomials that occur (symbolic preprocessing), the matrix is brought to
+// reduced row echelon form. Rows with new leading monomials are added to the
+// basis.
+func (s *groebnerState) f4Step() {
+	// Select the pairs of minimal total degree
+	selected := s.pairs.NextMinDegree()
+	s.stats.Reductions += len(selected)
+	s.stats.Matrices++
+
+	// Add both halves of each pair. Identical rows are only added once
+	type rowKey struct {
+		index int
+		shift [2]uint
+	}
+	seen := make(map[rowKey]bool)
+	rows := make([]map[[2]uint]ff.Element, 0, 2*len(selected))
+	addRow := func(i int, shift [2]uint) {
+		if k := (rowKey{i, shift}); !seen[k] {
+			seen[k] = true
+			rows = append(rows, shiftedCoefs(s.basis[i], shift))
+		}
+	}
+	for _, p := range selected {
+		lcm := [2]uint{p.Lcm[0], p.Lcm[1]}
+		for _, i := range [2]int{p.I, p.J} {
+			shift, _ := subtractDegs(lcm, s.lds[i])
+			addRow(i, shift)
+		}
+	}
+
+	// The leading monomials of the S-polynomial rows
+	done := make(map[[2]uint]bool)
+	for _, p := range selected {
+		done[[2]uint{p.Lcm[0], p.Lcm[1]}] = true
+	}
+	oldLds := make(map[[2]uint]bool, len(done))
+	for m := range done {
+		oldLds[m] = true
+	}
+
+	// Symbolic preprocessing: add a reducer for each monomial that occurs
+	for k := 0; k < len(rows); k++ {
+		for m := range rows[k] {
+			if done[m] {
+				continue
+			}
+			done[m] = true
+			for i, ld := range s.lds {
+				if shift, ok := subtractDegs(m, ld); ok {
+					addRow(i, shift)
+					oldLds[m] = true
+					break
+				}
+			}
+		}
+	}
+
+	// Index the columns by the monomials in decreasing order
+	monomials := make([][2]uint, 0, len(done))
+	for m := range done {
+		monomials = append(monomials, m)
+	}
+	sort.Slice(monomials, func(i, j int) bool {
+		return s.ord(monomials[i], monomials[j]) > 0
+	})
+	cols := make(map[[2]uint]int, len(monomials))
+	for i, m := range monomials {
+		cols[m] = i
+	}
+
+	field := s.basis[0].BaseField()
+	matrix := make([][]ff.Element, len(rows))
+	for i, row := range rows {
+		matrix[i] = make([]ff.Element, len(monomials))
+		for j := range matrix[i] {
+			matrix[i][j] = field.Zero()
+		}
+		for m, c := range row {
+			matrix[i][cols[m]] = c.Copy()
+		}
+	}
+
+	pivots := rowReduce(matrix)
+
+	// Rows whose leading monomial is new are added to the basis
+	r := s.basis[0].baseRing
+	for i, col := range pivots {
+		if oldLds[monomials[col]] {
+			continue
+		}
+		coefs := make(map[[2]uint]ff.Element)
+		for j, c := range matrix[i] {
+			if c.IsNonzero() {
+				coefs[monomials[j]] = c
+			}
+		}
+		if h := r.Polynomial(coefs); h.IsNonzero() {
+			s.update(h)
+		}
+	}
+}
+
+// rowReduce transforms the matrix into reduced row echelon form in-place. The
+// non-zero rows are moved to the top, and the pivot column of each of these is
+// returned.
+func rowReduce(matrix [][]ff.Element) (pivots []int) {
+	if len(matrix) == 0 {
+		return []int{}
+	}
+
+	pivots = make([]int, 0)
+	tmp := matrix[0][0].Copy()
+	rank := 0
+	for col := 0; col < len(matrix[0]) && rank < len(matrix); col++ {
+		// Find a row with non-zero entry in this column
+		pivot := -1
+		for i := rank; i < len(matrix); i++ {
+			if matrix[i][col].IsNonzero() {
+				pivot = i
+				break
+			}
+		}
+		if pivot == -1 {
+			continue
+		}
+		matrix[rank], matrix[pivot] = matrix[pivot], matrix[rank]
+
+		if lc := matrix[rank][col]; !lc.IsOne() {
+			lcInv := lc.Inv()
+			for j := col; j < len(matrix[rank]); j++ {
+				matrix[rank][j].Mult(lcInv)
+			}
+		}
+
+		for i := range matrix {
+			if i == rank || matrix[i][col].IsZero() {
+				continue
+			}
+			c := matrix[i][col].Copy()
+			for j := col; j < len(matrix[i]); j++ {
+				matrix[i][j].Sub(tmp.Prod(c, matrix[rank][j]))
+			}
+		}
+		pivots = append(pivots, col)
+		rank++
+	}
+	return pivots
+}
@@ -0,0 +1,186 @@
+package bivariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// isZeroDimensional determines whether the ideal with the given Gröbner basis
+// is zero-dimensional. That is, whether the basis contains polynomials with
+// leading monomials X^a and Y^b.
+func isZeroDimensional(basis []*Polynomial) bool {
+	pureX, pureY := false, false
+	for _, g := range basis {
+		ld := g.Ld()
+		pureX = pureX || (ld[1] == 0)
+		pureY = pureY || (ld[0] == 0)
+	}
+	return pureX && pureY
+}
+
+// fglmRow is a row of the echelon form used in the FGLM algorithm. The vector
+// vec is a linear combination of normal forms, and comb contains the
+// coefficients of the corresponding monomials.
+type fglmRow struct {
+	pivot [2]uint
+	vec   map[[2]uint]ff.Element
+	comb  map[[2]uint]ff.Element
+}
+
+// FGLM converts the Gröbner basis of the zero-dimensional ideal id into the
+// reduced Gröbner basis with respect to the order of the ring r. The result is
+// an ideal of r. To convert to an Order ord, let r be given by
+// DefRing(field, ord), where field is the base field of id.
+//
+// The conversion is done using the algorithm of Faugère, Gianni, Lazard, and
+// Mora (see [FGLM93]). The monomials are treated in increasing order with
+// respect to the new order, and their normal forms modulo id are computed. When
+// the normal form of a monomial is a linear combination of the normal forms of
+// smaller monomials, a new element of the Gröbner basis has been found. This is
+// often faster than computing the Gröbner basis directly, especially when the
+// target order is lexicographical.
+//
+// If the generators of id do not form a Gröbner basis, such a basis is
+// computed first. If id is not zero-dimensional, or if r is a proper quotient
+// ring, an InputValue-error is returned. If r is defined over a different
+// field, an InputIncompatible-error is returned.
+func (id *Ideal) FGLM(r *QuotientRing) (*Ideal, error) {
+	const op = "Converting Gröbner basis"
+
+	if r.id != nil {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Target ring is already reduced modulo an ideal",
+		)
+	}
+	if r.baseField != id.baseField {
+		return nil, errors.New(
+			op, errors.InputIncompatible,
+			"Target ring %v and ideal %v are defined over different fields",
+			r, id,
+		)
+	}
+
+	var gb *Ideal
+	if id.IsGroebner() {
+		gb = id.Copy()
+	} else {
+		gb = id.GroebnerBasis()
+	}
+	_ = gb.ReduceBasis() // Ignore error since gb is a Gröbner basis
+
+	if !isZeroDimensional(gb.generators) {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Ideal %v is not zero-dimensional", id,
+		)
+	}
+
+	oldRing := gb.generators[0].baseRing
+	normalForm := func(deg [2]uint) map[[2]uint]ff.Element {
+		m := oldRing.Zero()
+		m.SetCoefPtr(deg, r.baseField.One())
+		rem, _ := m.Rem(gb.generators...) // Ignore error since rings are equal
+		return rem.coefs
+	}
+
+	rows := make([]fglmRow, 0)
+	newBasis := make([]*Polynomial, 0)
+	newLds := make([][2]uint, 0)
+	processed := make(map[[2]uint]bool)
+	candidates := [][2]uint{{0, 0}}
+
+	tmp := r.baseField.Zero()
+	for len(candidates) > 0 {
+		// Select the smallest candidate with respect to the new order
+		best := 0
+		for i, c := range candidates {
+			if r.ord(c, candidates[best]) < 0 {
+				best = i
+			}
+		}
+		m := candidates[best]
+		candidates = append(candidates[:best], candidates[best+1:]...)
+
+		if processed[m] {
+			continue
+		}
+		processed[m] = true
+		divisible := false
+		for _, ld := range newLds {
+			if degDivides(ld, m) {
+				divisible = true
+				break
+			}
+		}
+		if divisible {
+			continue
+		}
+
+		// Reduce the normal form of m by the rows found so far
+		vec := normalForm(m)
+		comb := map[[2]uint]ff.Element{m: r.baseField.One()}
+		for _, row := range rows {
+			c, ok := vec[row.pivot]
+			if !ok {
+				continue
+			}
+			c = c.Copy()
+			for d, e := range row.vec {
+				decrementMapCoef(vec, d, tmp.Prod(c, e))
+			}
+			for d, e := range row.comb {
+				decrementMapCoef(comb, d, tmp.Prod(c, e))
+			}
+		}
+
+		if len(vec) == 0 {
+			// Linear dependency. Since m is larger than the other monomials in
+			// comb, it is the leading monomial of the new basis element
+			newBasis = append(newBasis, r.Polynomial(comb))
+			newLds = append(newLds, m)
+			continue
+		}
+
+		// Normalize the row with respect to the largest monomial in vec
+		var pivot [2]uint
+		first := true
+		for d := range vec {
+			if first || oldRing.ord(d, pivot) > 0 {
+				pivot, first = d, false
+			}
+		}
+		inv := vec[pivot].Inv()
+		for _, e := range vec {
+			e.Mult(inv)
+		}
+		for _, e := range comb {
+			e.Mult(inv)
+		}
+		rows = append(rows, fglmRow{pivot: pivot, vec: vec, comb: comb})
+
+		candidates = append(candidates, [2]uint{m[0] + 1, m[1]}, [2]uint{m[0], m[1] + 1})
+	}
+
+	return &Ideal{
+		ring:       r.ring,
+		generators: newBasis,
+		isGroebner: 1,
+		isMinimal:  1,
+		isReduced:  1,
+		algorithm:  id.algorithm,
+	}, nil
+}
+
+// decrementMapCoef decrements the coefficient of deg in m by val. Zero
+// coefficients are removed from the map.
+func decrementMapCoef(m map[[2]uint]ff.Element, deg [2]uint, val ff.Element) {
+	if c, ok := m[deg]; ok {
+		c.Sub(val)
+		if c.IsZero() {
+			delete(m, deg)
+		}
+	} else if val.IsNonzero() {
+		m[deg] = val.Neg()
+	}
+}
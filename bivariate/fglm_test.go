@@ -0,0 +1,90 @@
+package bivariate
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+)
+
+// sameStrings determines whether the generators of id1 and id2 have the same
+// string representations up to permutation. This allows comparison of ideals
+// in different rings.
+func sameStrings(id1, id2 *Ideal) bool {
+	if len(id1.generators) != len(id2.generators) {
+		return false
+	}
+outer:
+	for _, g := range id1.generators {
+		for _, h := range id2.generators {
+			if g.String() == h.String() {
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func TestFGLM(t *testing.T) {
+	orders := []Order{
+		Lex(true), Lex(false), DegLex(false), WDegRevLex(2, 5, true),
+	}
+
+	for _, card := range []uint{2, 3, 4, 5, 7, 9} {
+		field := defineField(card, t)
+		src := DefRing(field, DegRevLex(true))
+		for rep := 0; rep < 3; rep++ {
+			// The field equations ensure that the ideal is zero-dimensional
+			q := field.Card()
+			gens := []*Polynomial{
+				src.PolynomialFromSigned(map[[2]uint]int{{q, 0}: 1, {1, 0}: -1}),
+				src.PolynomialFromSigned(map[[2]uint]int{{0, q}: 1, {0, 1}: -1}),
+				randomPolynomial(src, 4, 4),
+			}
+			id, _ := src.NewIdeal(gens...)
+
+			for _, ord := range orders {
+				dst := DefRing(field, ord)
+				converted, err := id.FGLM(dst)
+				if err != nil {
+					t.Fatalf("FGLM failed for %v: %v", id, err)
+				}
+
+				dstGens := make([]*Polynomial, len(gens))
+				for i, g := range gens {
+					dstGens[i] = dst.Polynomial(g.coefs)
+				}
+				direct, _ := dst.NewIdeal(dstGens...)
+				direct = direct.GroebnerBasis()
+				_ = direct.ReduceBasis()
+
+				if !sameStrings(converted, direct) {
+					t.Errorf(
+						"FGLM of %v gave %v, but expected %v",
+						id, converted.ShortString(), direct.ShortString(),
+					)
+				}
+				if !converted.IsReduced() {
+					t.Errorf("FGLM returned %v, which is not reduced", converted)
+				}
+			}
+		}
+	}
+}
+
+func TestFGLMErrors(t *testing.T) {
+	field := defineField(5, t)
+	r := DefRing(field, DegRevLex(true))
+	f, _ := r.PolynomialFromString("X^2 + Y")
+	id, _ := r.NewIdeal(f)
+
+	_, err := id.FGLM(DefRing(field, Lex(true)))
+	assertError(t, err, errors.InputValue, "FGLM of ideal of positive dimension")
+
+	_, err = id.FGLM(DefRing(defineField(7, t), Lex(true)))
+	assertError(t, err, errors.InputIncompatible, "FGLM to ring over different field")
+
+	qr, _ := r.Quotient(id)
+	_, err = id.FGLM(qr)
+	assertError(t, err, errors.InputValue, "FGLM to quotient ring")
+}
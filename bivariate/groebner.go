@@ -3,6 +3,7 @@ package bivariate
 import (
 	"github.com/ReneBoedker/algobra/errors"
 	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/internal/groebner"
 )
 
 func max(values ...uint) uint {
@@ -47,47 +48,129 @@ func SPolynomial(f, g *Polynomial) (*Polynomial, error) {
 	return q1[0].Mult(f).Minus(q2[0].Mult(g)), nil
 }
 
+// GroebnerStats contains statistics about a Gröbner basis computation.
+type GroebnerStats struct {
+	Pairs          int // Number of critical pairs formed
+	Coprime        int // Pairs discarded by the product criterion
+	Chain          int // Pairs discarded by the chain criterion
+	Reductions     int // S-polynomials that were reduced
+	ZeroReductions int // S-polynomials that reduced to zero (Buchberger only)
+	Matrices       int // Matrices that were row reduced (F4 only)
+}
+
+// Discarded returns the total number of pairs discarded by the criteria.
+func (s *GroebnerStats) Discarded() int {
+	return s.Coprime + s.Chain
+}
+
 // GroebnerBasis computes a Gröbner basis for id. The result is returned as a
 // new ideal object.
+//
+// The generators of the result consist of the generators of id together with
+// the non-zero remainders of the S-polynomials that were reduced. See
+// GroebnerBasisWithStats for details on the algorithm.
 func (id *Ideal) GroebnerBasis() *Ideal {
+	gb, _ := id.GroebnerBasisWithStats()
+	return gb
+}
+
+// degDivides determines whether the monomial with degree a divides the monomial
+// with degree b.
+func degDivides(a, b [2]uint) bool {
+	return a[0] <= b[0] && a[1] <= b[1]
+}
+
+// groebnerState holds the state of Buchberger's algorithm.
+type groebnerState struct {
+	ord   Order
+	basis []*Polynomial
+	lds   [][2]uint
+	pairs *groebner.PairSet
+	stats GroebnerStats
+}
+
+// newGroebnerState returns the state of a Gröbner basis computation with no
+// polynomials in the basis.
+func newGroebnerState(ord Order, cap int) *groebnerState {
+	return &groebnerState{
+		ord:   ord,
+		basis: make([]*Polynomial, 0, cap),
+		lds:   make([][2]uint, 0, cap),
+		pairs: groebner.NewPairSet(func(a, b []uint) int {
+			return ord([2]uint{a[0], a[1]}, [2]uint{b[0], b[1]})
+		}),
+	}
+}
+
+// update adds h to the basis and updates the list of critical pairs.
+func (s *groebnerState) update(h *Polynomial) {
+	hLd := h.Ld()
+	s.basis = append(s.basis, h)
+	s.lds = append(s.lds, hLd)
+	s.pairs.Update([]uint{hLd[0], hLd[1]})
+}
+
+// finalStats returns the statistics of the computation.
+func (s *groebnerState) finalStats() *GroebnerStats {
+	s.stats.Pairs = s.pairs.Stats.Pairs
+	s.stats.Coprime = s.pairs.Stats.Coprime
+	s.stats.Chain = s.pairs.Stats.Chain
+	return &s.stats
+}
+
+// buchbergerStep reduces the S-polynomial of the next critical pair and adds
+// the remainder to the basis if it is non-zero.
+func (s *groebnerState) buchbergerStep() {
+	p := s.pairs.Next()
+
+	sPoly, _ := SPolynomial(s.basis[p.I], s.basis[p.J])
+	// Compute the remainder of s. Ignoring error is OK since ideal
+	// generators are compatible
+	r, _ := sPoly.Rem(s.basis...)
+	s.stats.Reductions++
+	if r.IsZero() {
+		s.stats.ZeroReductions++
+		return
+	}
+	s.update(r)
+}
+
+// GroebnerBasisWithStats computes a Gröbner basis for id and returns it as a
+// new ideal object. In addition, it returns statistics about the computation.
+//
+// By default, the computation uses Buchberger's algorithm with the normal
+// selection strategy. That is, the critical pair with the smallest least common
+// multiple of the leading monomials is treated first. Pairs are discarded using
+// the product criterion and the chain criterion as described by Gebauer and
+// Möller (see [BW93; Section 5.5]). The algorithm can be changed using
+// SetGroebnerAlgorithm.
+func (id *Ideal) GroebnerBasisWithStats() (*Ideal, *GroebnerStats) {
 	if id.isGroebner == 1 {
-		return id.Copy()
+		return id.Copy(), &GroebnerStats{}
 	}
 
-	gb := make([]*Polynomial, len(id.generators))
-	for i, g := range id.generators {
-		gb[i] = g.Copy()
+	s := newGroebnerState(id.ring.ord, len(id.generators))
+	for _, g := range id.generators {
+		s.update(g.Copy())
 	}
 
-	for true {
-		newGens := make([]*Polynomial, 0)
-		for i, f := range gb {
-			for j, g := range gb {
-				if j <= i {
-					continue
-				}
-				s, _ := SPolynomial(f, g)
-				// Compute the remainder of s. Ignoring error is OK since ideal
-				// generators are compatible
-				r, _ := s.Rem(gb...)
-				if r.IsNonzero() {
-					newGens = append(newGens, r)
-				}
-			}
-		}
-		if len(newGens) == 0 {
-			break
+	for s.pairs.Len() > 0 {
+		switch id.algorithm {
+		case F4:
+			s.f4Step()
+		default:
+			s.buchbergerStep()
 		}
-		gb = append(gb, newGens...)
 	}
 
 	return &Ideal{
 		ring:       id.ring,
-		generators: gb,
+		generators: s.basis,
 		isGroebner: 1,
 		isMinimal:  0,
 		isReduced:  0,
-	}
+		algorithm:  id.algorithm,
+	}, s.finalStats()
 }
 
 // IsGroebner returns a boolean describing whether the generators of id form a
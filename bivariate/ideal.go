@@ -14,6 +14,7 @@ type Ideal struct {
 	isGroebner int8 // 0=undecided, 1=true, -1=false
 	isMinimal  int8 // 0=undecided, 1=true, -1=false
 	isReduced  int8 // 0=undecided, 1=true, -1=false
+	algorithm  GroebnerAlgorithm
 }
 
 // ShortString returns a short string description of id. More precisely, it
@@ -88,6 +89,7 @@ func (id *Ideal) Copy() *Ideal {
 		isGroebner: id.isGroebner,
 		isMinimal:  id.isMinimal,
 		isReduced:  id.isReduced,
+		algorithm:  id.algorithm,
 	}
 }
 
@@ -100,6 +102,11 @@ func (id *Ideal) Generators() []*Polynomial {
 	return gens
 }
 
+// Ring returns the ring over which the generators of id are defined.
+func (id *Ideal) Ring() *QuotientRing {
+	return id.generators[0].baseRing
+}
+
 // Reduce sets f to f modulo id.
 //
 // Note that when the generators of id do not form a Gröbner basis, such a basis
@@ -1,6 +1,8 @@
 package bivariate
 
 import (
+	"sort"
+
 	"github.com/ReneBoedker/algobra/auxmath"
 	"github.com/ReneBoedker/algobra/errors"
 	"github.com/ReneBoedker/algobra/finitefield/ff"
@@ -8,6 +10,8 @@ import (
 
 // Interpolate computes an interpolation polynomial evaluating to values in the
 // specified points. The resulting polynomial has degree at most 2*len(points)
+// See InterpolateOnSupport for interpolation by polynomials with prescribed
+// monomials.
 //
 // It returns an InputValue-error if the number of points and values differ, or
 // if points are not distinct.
@@ -55,6 +59,198 @@ func (r *QuotientRing) Interpolate(
 	return f, nil
 }
 
+// InterpolateOnSupport computes the polynomial supported on the given
+// monomials that evaluates to values in the specified points. The support
+// could, for instance, be the standard monomials of r.
+//
+// The coefficients are found by solving the linear system whose matrix has the
+// evaluations of the monomials in the points as rows. A unique solution exists
+// exactly when the points are unisolvent for the support. That is, when the
+// number of points equals the number of monomials and no non-zero polynomial
+// supported on the monomials vanishes in all the points. If r is a quotient
+// ring, the result is reduced modulo its ideal.
+//
+// It returns an InputValue-error if the number of points, values, and
+// monomials differ, if the points are not distinct, or if the points are not
+// unisolvent for the support.
+func (r *QuotientRing) InterpolateOnSupport(
+	points [][2]ff.Element,
+	values []ff.Element,
+	support [][2]uint,
+) (*Polynomial, error) {
+	const op = "Computing interpolation on support"
+
+	if len(points) != len(values) {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Different number of interpolation points and values (%d and %d)",
+			len(points), len(values),
+		)
+	}
+	if len(points) != len(support) {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"The number of points (%d) must equal the number of monomials (%d)",
+			len(points), len(support),
+		)
+	}
+	if !allDistinct(points) {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Interpolation points must be distinct",
+		)
+	}
+
+	// Each row contains the evaluations of the monomials in a point followed
+	// by the value
+	matrix := make([][]ff.Element, len(points))
+	for i, p := range points {
+		matrix[i] = make([]ff.Element, len(support)+1)
+		for j, m := range support {
+			matrix[i][j] = p[0].Pow(m[0]).Mult(p[1].Pow(m[1]))
+		}
+		matrix[i][len(support)] = values[i].Copy()
+	}
+
+	pivots := rowReduce(matrix)
+	if len(pivots) < len(support) || (len(support) > 0 && pivots[len(support)-1] != len(support)-1) {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Points are not unisolvent for the support %v", support,
+		)
+	}
+
+	coefs := make(map[[2]uint]ff.Element, len(support))
+	for j, m := range support {
+		coefs[m] = matrix[j][len(support)]
+	}
+	return r.Polynomial(coefs), nil
+}
+
+// VanishingPolynomial returns a non-zero polynomial supported on the given
+// monomials that vanishes with multiplicity at least m in each of the points.
+// That is, all terms of total degree less than m vanish when the polynomial is
+// expanded around each point. This is the interpolation step of list decoding
+// algorithms such as the Guruswami–Sudan algorithm.
+//
+// The coefficients are found as a solution to a homogeneous linear system with
+// m(m+1)/2 equations per point. Among the solutions, one with the smallest
+// leading monomial with respect to the order of r is returned. A non-zero
+// solution always exists if the number of monomials exceeds the number of
+// equations.
+//
+// It returns an InputValue-error if r is a quotient ring, if m is zero, if the
+// points are not distinct, or if no non-zero polynomial with the given support
+// vanishes in the points.
+func (r *QuotientRing) VanishingPolynomial(
+	points [][2]ff.Element,
+	m uint,
+	support [][2]uint,
+) (*Polynomial, error) {
+	const op = "Computing vanishing polynomial"
+
+	if r.id != nil {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Vanishing polynomials cannot be computed over a quotient ring",
+		)
+	}
+	if m == 0 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Multiplicity must be positive",
+		)
+	}
+	if !allDistinct(points) {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Interpolation points must be distinct",
+		)
+	}
+	if len(support) == 0 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"At least one monomial is required",
+		)
+	}
+
+	// Sort the monomials in increasing order. Then the first non-pivot column
+	// of the reduced matrix gives the solution with smallest leading monomial
+	monomials := append([][2]uint{}, support...)
+	sort.Slice(monomials, func(i, j int) bool {
+		return r.ord(monomials[i], monomials[j]) < 0
+	})
+	maxDeg := uint(0)
+	for _, mon := range monomials {
+		for _, d := range mon {
+			if d > maxDeg {
+				maxDeg = d
+			}
+		}
+	}
+	binom := r.binomials(maxDeg)
+
+	// The coefficient of X^aY^b in f(X+x,Y+y) is the sum of
+	// binom(i,a)*binom(j,b)*x^(i-a)*y^(j-b) times the coefficient of X^iY^j
+	matrix := make([][]ff.Element, 0, len(points)*int(m*(m+1)/2))
+	for _, p := range points {
+		for a := uint(0); a < m; a++ {
+			for b := uint(0); a+b < m; b++ {
+				row := make([]ff.Element, len(monomials))
+				for j, mon := range monomials {
+					if mon[0] < a || mon[1] < b {
+						row[j] = r.baseField.Zero()
+						continue
+					}
+					row[j] = binom[mon[0]][a].Times(binom[mon[1]][b])
+					row[j].Mult(p[0].Pow(mon[0] - a)).Mult(p[1].Pow(mon[1] - b))
+				}
+				matrix = append(matrix, row)
+			}
+		}
+	}
+
+	pivots := rowReduce(matrix)
+	free := len(pivots)
+	for i, col := range pivots {
+		if col != i {
+			free = i
+			break
+		}
+	}
+	if free >= len(monomials) {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"No non-zero polynomial with support %v vanishes in the points",
+			support,
+		)
+	}
+
+	// Set the coefficient of the free monomial to one. The remaining non-zero
+	// coefficients correspond to the pivots in the preceding columns
+	coefs := make(map[[2]uint]ff.Element, free+1)
+	coefs[monomials[free]] = r.baseField.One()
+	for i := 0; i < free; i++ {
+		coefs[monomials[pivots[i]]] = matrix[i][free].Neg()
+	}
+	return r.Polynomial(coefs), nil
+}
+
+// binomials returns a table of the binomial coefficients binom(i,j) as
+// elements of the base field for 0 <= j <= i <= n.
+func (r *QuotientRing) binomials(n uint) [][]ff.Element {
+	binom := make([][]ff.Element, n+1)
+	for i := range binom {
+		binom[i] = make([]ff.Element, i+1)
+		binom[i][0] = r.baseField.One()
+		binom[i][i] = r.baseField.One()
+		for j := 1; j < i; j++ {
+			binom[i][j] = binom[i-1][j-1].Plus(binom[i-1][j])
+		}
+	}
+	return binom
+}
+
 // allDistinct checks if given points are all distinct
 func allDistinct(points [][2]ff.Element) bool {
 	unique := make(map[[2]string]struct{}, len(points))
@@ -130,6 +130,215 @@ func TestInterpolationErrors(t *testing.T) {
 	assertError(t, err, errors.InputValue, "Interpolation on duplicate points")
 }
 
+func TestInterpolateOnSupport(t *testing.T) {
+	field := defineField(17, t)
+	ring := DefRing(field, DegRevLex(true))
+	elems := field.Elements()
+
+	check := func(r *QuotientRing, points [][2]ff.Element, support [][2]uint) {
+		values := make([]ff.Element, len(points))
+		for i := range values {
+			values[i] = field.RandElement()
+		}
+		f, err := r.InterpolateOnSupport(points, values, support)
+		if err != nil {
+			t.Errorf("Interpolation on support %v returned error: %q", support, err)
+			return
+		}
+		inSupport := make(map[[2]uint]bool, len(support))
+		for _, m := range support {
+			inSupport[m] = true
+		}
+		for _, d := range f.SortedDegrees() {
+			if !inSupport[d] {
+				t.Errorf("Interpolation polynomial %v is not supported on %v", f, support)
+			}
+		}
+		for i, p := range points {
+			if !f.Eval(p).Equal(values[i]) {
+				t.Errorf(
+					"Interpolation polynomial %v evaluates to %v in %v, but expected %v",
+					f, f.Eval(p), p, values[i],
+				)
+			}
+		}
+	}
+
+	// Points in a grid are unisolvent for the corresponding box of monomials
+	for rep := 0; rep < 20; rep++ {
+		nX, nY := prg.Intn(4)+1, prg.Intn(4)+1
+		xs, ys := prg.Perm(len(elems))[:nX], prg.Perm(len(elems))[:nY]
+		points := make([][2]ff.Element, 0, nX*nY)
+		support := make([][2]uint, 0, nX*nY)
+		for i, x := range xs {
+			for j, y := range ys {
+				points = append(points, [2]ff.Element{elems[x], elems[y]})
+				support = append(support, [2]uint{uint(i), uint(j)})
+			}
+		}
+		check(ring, points, support)
+	}
+
+	// The rational points of the Hermitian curve are unisolvent for the
+	// standard monomials
+	herm := hermitianRing(3, t)
+	field = herm.baseField
+	std, err := herm.StandardMonomials()
+	if err != nil {
+		t.Fatalf("Failed to compute standard monomials: %v", err)
+	}
+	points := make([][2]ff.Element, 0)
+	for _, a := range field.Elements() {
+	outer:
+		for _, b := range field.Elements() {
+			for _, g := range herm.id.generators {
+				if g.Eval([2]ff.Element{a, b}).IsNonzero() {
+					continue outer
+				}
+			}
+			points = append(points, [2]ff.Element{a, b})
+		}
+	}
+	check(herm, points, std)
+}
+
+func TestInterpolateOnSupportErrors(t *testing.T) {
+	field := defineField(13, t)
+	ring := DefRing(field, DegLex(true))
+
+	points := [][2]ff.Element{
+		{field.Zero(), field.Zero()},
+		{field.Zero(), field.One()},
+		{field.Zero(), field.ElementFromUnsigned(5)},
+	}
+	values := []ff.Element{field.One(), field.Zero(), field.One()}
+
+	_, err := ring.InterpolateOnSupport(points, values[:2], [][2]uint{{0, 0}, {0, 1}, {0, 2}})
+	assertError(t, err, errors.InputValue, "Interpolation on support with more points than values")
+
+	_, err = ring.InterpolateOnSupport(points, values, [][2]uint{{0, 0}, {0, 1}})
+	assertError(t, err, errors.InputValue, "Interpolation on support with too few monomials")
+
+	// The points are on the line X=0, so they are not unisolvent for {1, X, Y}
+	_, err = ring.InterpolateOnSupport(points, values, [][2]uint{{0, 0}, {1, 0}, {0, 1}})
+	assertError(t, err, errors.InputValue, "Interpolation on support with non-unisolvent points")
+
+	points[2] = points[1]
+	_, err = ring.InterpolateOnSupport(points, values, [][2]uint{{0, 0}, {0, 1}, {0, 2}})
+	assertError(t, err, errors.InputValue, "Interpolation on support with duplicate points")
+}
+
+// weightedSupport returns the monomials X^iY^j with i+w*j at most maxDeg.
+func weightedSupport(w, maxDeg uint) [][2]uint {
+	out := make([][2]uint, 0)
+	for j := uint(0); j*w <= maxDeg; j++ {
+		for i := uint(0); i+j*w <= maxDeg; i++ {
+			out = append(out, [2]uint{i, j})
+		}
+	}
+	return out
+}
+
+// randomPoints returns n distinct random points.
+func randomPoints(field ff.Field, n int) [][2]ff.Element {
+	points := make([][2]ff.Element, 0, n)
+	for len(points) < n {
+		p := [2]ff.Element{field.RandElement(), field.RandElement()}
+		if allDistinct(append(points, p)) {
+			points = append(points, p)
+		}
+	}
+	return points
+}
+
+func TestVanishingPolynomial(t *testing.T) {
+	fieldLoop(func(field ff.Field) {
+		for rep := 0; rep < 5; rep++ {
+			w := uint(prg.Intn(3) + 1)
+			ring := DefRing(field, WDegLex(1, w, false))
+			m := uint(prg.Intn(3) + 1)
+			nPoints := prg.Intn(4) + 1
+			if card := int(field.Card()); nPoints > card*card {
+				nPoints = card * card
+			}
+			points := randomPoints(field, nPoints)
+
+			// Choose the smallest weighted degree that guarantees a solution
+			nEquations := nPoints * int(m*(m+1)/2)
+			maxDeg := uint(0)
+			for len(weightedSupport(w, maxDeg)) <= nEquations {
+				maxDeg++
+			}
+			support := weightedSupport(w, maxDeg)
+
+			f, err := ring.VanishingPolynomial(points, m, support)
+			if err != nil {
+				t.Errorf("VanishingPolynomial returned an error: %q", err)
+				continue
+			}
+			if f.IsZero() {
+				t.Errorf("VanishingPolynomial returned the zero polynomial")
+				continue
+			}
+			for d := range f.coefs {
+				if d[0]+w*d[1] > maxDeg {
+					t.Errorf("VanishingPolynomial returned %v with X^%dY^%d "+
+						"outside the support", f, d[0], d[1])
+				}
+			}
+
+			// Expand f around each point and check the low-degree terms
+			for _, p := range points {
+				x := ring.Polynomial(map[[2]uint]ff.Element{
+					{1, 0}: field.One(), {0, 0}: p[0],
+				})
+				y := ring.Polynomial(map[[2]uint]ff.Element{
+					{0, 1}: field.One(), {0, 0}: p[1],
+				})
+				shifted := ring.Zero()
+				for d, c := range f.coefs {
+					shifted.Add(x.Pow(d[0]).Mult(y.Pow(d[1])).SetScale(c))
+				}
+				for d := range shifted.coefs {
+					if d[0]+d[1] < m {
+						t.Errorf("%v does not vanish with multiplicity %d in "+
+							"(%v, %v)", f, m, p[0], p[1])
+						break
+					}
+				}
+			}
+		}
+	})
+}
+
+func TestVanishingPolynomialErrors(t *testing.T) {
+	field := defineField(13, t)
+	ring := DefRing(field, WDegLex(1, 2, false))
+	points := [][2]ff.Element{
+		{field.Zero(), field.One()},
+		{field.One(), field.ElementFromUnsigned(5)},
+	}
+	support := weightedSupport(2, 4)
+
+	_, err := ring.VanishingPolynomial(points, 0, support)
+	assertError(t, err, errors.InputValue, "VanishingPolynomial with multiplicity zero")
+
+	_, err = ring.VanishingPolynomial(points, 1, nil)
+	assertError(t, err, errors.InputValue, "VanishingPolynomial with empty support")
+
+	// Only the constant polynomial is allowed, and it does not vanish
+	_, err = ring.VanishingPolynomial(points, 1, [][2]uint{{0, 0}})
+	assertError(t, err, errors.InputValue, "VanishingPolynomial with too few monomials")
+
+	_, err = ring.VanishingPolynomial([][2]ff.Element{points[0], points[0]}, 1, support)
+	assertError(t, err, errors.InputValue, "VanishingPolynomial with duplicate points")
+
+	id, _ := ring.NewIdeal(ring.PolynomialFromSigned(map[[2]uint]int{{0, 3}: 1, {1, 0}: -1}))
+	qRing, _ := ring.Quotient(id)
+	_, err = qRing.VanishingPolynomial(points, 1, support)
+	assertError(t, err, errors.InputValue, "VanishingPolynomial over quotient ring")
+}
+
 // func TestIter(t *testing.T) {
 // 	for ci := newCombinIter(5, 3); ci.Active(); ci.Next() {
 // 		fmt.Println(ci.slice)
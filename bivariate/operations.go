@@ -0,0 +1,358 @@
+package bivariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/multivariate"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+// polynomialRing returns the ring over which the generators of id are defined.
+// If this ring is a proper quotient ring, or if the generators of one of the
+// other ideals are defined over a different ring, an error is returned.
+func (id *Ideal) polynomialRing(op errors.Op, other ...*Ideal) (*QuotientRing, error) {
+	r := id.generators[0].baseRing
+	if r.id != nil {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Ideal %v is defined over a quotient ring", id,
+		)
+	}
+	for _, o := range other {
+		if o.generators[0].baseRing != r {
+			return nil, errors.New(
+				op, errors.InputIncompatible,
+				"Ideals %v and %v are defined over different rings", id, o,
+			)
+		}
+	}
+	return r, nil
+}
+
+// Contains determines whether f is a member of id. If f is defined over a
+// different ring than the generators of id, or if f has a non-nil error status,
+// Contains returns false.
+//
+// If the generators of id do not form a Gröbner basis, such a basis is computed.
+// This does not alter the representation of id.
+func (id *Ideal) Contains(f *Polynomial) bool {
+	if f.err != nil || f.baseRing != id.generators[0].baseRing {
+		return false
+	}
+
+	gb := id
+	if !id.IsGroebner() {
+		gb = id.GroebnerBasis()
+	}
+	rem, err := f.Rem(gb.generators...)
+	return err == nil && rem.IsZero()
+}
+
+// containsIdeal determines whether every generator of other is a member of id.
+func (id *Ideal) containsIdeal(other *Ideal) bool {
+	for _, g := range other.generators {
+		if !id.Contains(g) {
+			return false
+		}
+	}
+	return true
+}
+
+// Sum returns the sum of id and other. That is, the ideal generated by the
+// generators of both ideals.
+//
+// If the ideals are defined over different rings, an InputIncompatible-error is
+// returned. If they are defined over a quotient ring, an InputValue-error is
+// returned.
+func (id *Ideal) Sum(other *Ideal) (*Ideal, error) {
+	const op = "Computing sum of ideals"
+
+	r, err := id.polynomialRing(op, other)
+	if err != nil {
+		return nil, err
+	}
+
+	gens := make([]*Polynomial, 0, len(id.generators)+len(other.generators))
+	gens = append(gens, id.generators...)
+	gens = append(gens, other.generators...)
+
+	out, err := r.NewIdeal(gens...)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	out.algorithm = id.algorithm
+	return out, nil
+}
+
+// Product returns the product of id and other. That is, the ideal generated by
+// the pairwise products of their generators.
+//
+// If the ideals are defined over different rings, an InputIncompatible-error is
+// returned. If they are defined over a quotient ring, an InputValue-error is
+// returned.
+func (id *Ideal) Product(other *Ideal) (*Ideal, error) {
+	const op = "Computing product of ideals"
+
+	r, err := id.polynomialRing(op, other)
+	if err != nil {
+		return nil, err
+	}
+
+	gens := make([]*Polynomial, 0, len(id.generators)*len(other.generators))
+	for _, f := range id.generators {
+		for _, g := range other.generators {
+			gens = append(gens, f.Times(g))
+		}
+	}
+
+	out, err := r.NewIdeal(gens...)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	out.algorithm = id.algorithm
+	return out, nil
+}
+
+// liftTo appends the terms of t^tDeg*f to degs and coefs. The variables of the
+// multivariate ring are t, X, and Y, in that order.
+func liftTo(degs [][]uint, coefs []ff.Element, f *Polynomial, tDeg uint) ([][]uint, []ff.Element) {
+	for d, c := range f.coefs {
+		degs = append(degs, []uint{tDeg, d[0], d[1]})
+		coefs = append(coefs, c)
+	}
+	return degs, coefs
+}
+
+// Intersection returns the intersection of id and other. The generators of the
+// result form a reduced Gröbner basis.
+//
+// The intersection is computed by elimination (see [CLO15; Section 4.3]). If
+// id=<f_1,...,f_n> and other=<g_1,...,g_m>, the intersection consists of the
+// polynomials without t in the ideal
+//
+//	<t*f_1,...,t*f_n, (1-t)*g_1,...,(1-t)*g_m>
+//
+// of F[t,X,Y]. These are found by computing a Gröbner basis with respect to a
+// block order in which t is larger than any monomial in X and Y.
+//
+// If the ideals are defined over different rings, an InputIncompatible-error is
+// returned. If they are defined over a quotient ring, an InputValue-error is
+// returned.
+func (id *Ideal) Intersection(other *Ideal) (*Ideal, error) {
+	const op = "Computing intersection of ideals"
+
+	r, err := id.polynomialRing(op, other)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the order of r on X and Y such that the polynomials without t form a
+	// Gröbner basis in r
+	ord := func(deg1, deg2 []uint) int {
+		return r.ord([2]uint{deg1[0], deg1[1]}, [2]uint{deg2[0], deg2[1]})
+	}
+	mr, err := multivariate.DefRing(
+		r.baseField, []string{"t", "x", "y"},
+		multivariate.Block(1, multivariate.Lex(), ord),
+	)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Internal, err)
+	}
+
+	gens := make([]*multivariate.Polynomial, 0, len(id.generators)+len(other.generators))
+	for _, f := range id.generators {
+		degs, coefs := liftTo(nil, nil, f, 1)
+		gens = append(gens, mr.Polynomial(degs, coefs))
+	}
+	for _, g := range other.generators {
+		degs, coefs := liftTo(nil, nil, g, 0)
+		degs, coefs = liftTo(degs, coefs, g.Neg(), 1)
+		gens = append(gens, mr.Polynomial(degs, coefs))
+	}
+
+	mId, err := mr.NewIdeal(gens...)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Internal, err)
+	}
+	gb := mId.GroebnerBasis()
+	_ = gb.ReduceBasis() // Ignore error since gb is a Gröbner basis
+
+	out := &Ideal{
+		ring:       r.ring,
+		generators: make([]*Polynomial, 0),
+		isGroebner: 1,
+		isMinimal:  1,
+		isReduced:  1,
+		algorithm:  id.algorithm,
+	}
+	for _, g := range gb.Generators() {
+		if g.Ld()[0] != 0 {
+			continue
+		}
+		// Since t is larger than all other monomials, no term contains t
+		f := r.zeroWithCap(len(g.SortedDegrees()))
+		for _, d := range g.SortedDegrees() {
+			f.SetCoefPtr([2]uint{d[1], d[2]}, g.Coef(d))
+		}
+		out.generators = append(out.generators, f)
+	}
+	return out, nil
+}
+
+// Colon returns the colon ideal (id : other). That is, the ideal consisting of
+// the polynomials f such that f*g is in id for all g in other. The generators
+// of the result form a reduced Gröbner basis.
+//
+// For each generator g of other, the ideal (id : g) is computed by dividing the
+// generators of the intersection of id and <g> by g. The result is the
+// intersection of these ideals (see [CLO15; Section 4.4]).
+//
+// If the ideals are defined over different rings, an InputIncompatible-error is
+// returned. If they are defined over a quotient ring, an InputValue-error is
+// returned.
+func (id *Ideal) Colon(other *Ideal) (*Ideal, error) {
+	const op = "Computing colon ideal"
+
+	r, err := id.polynomialRing(op, other)
+	if err != nil {
+		return nil, err
+	}
+
+	var out *Ideal
+	for _, g := range other.generators {
+		principal, _ := r.NewIdeal(g) // Generators are non-zero
+		inter, err := id.Intersection(principal)
+		if err != nil {
+			return nil, errors.Wrap(op, errors.Inherit, err)
+		}
+
+		quotients := make([]*Polynomial, len(inter.generators))
+		for i, h := range inter.generators {
+			q, _, err := h.QuoRem(g)
+			if err != nil {
+				return nil, errors.Wrap(op, errors.Inherit, err)
+			}
+			quotients[i] = q[0]
+		}
+		tmp, err := r.NewIdeal(quotients...)
+		if err != nil {
+			return nil, errors.Wrap(op, errors.Inherit, err)
+		}
+		tmp.algorithm = id.algorithm
+
+		if out == nil {
+			out = tmp
+		} else if out, err = out.Intersection(tmp); err != nil {
+			return nil, errors.Wrap(op, errors.Inherit, err)
+		}
+	}
+
+	if out.IsReduced() {
+		return out, nil
+	}
+	out = out.GroebnerBasis()
+	_ = out.ReduceBasis() // Ignore error since out is a Gröbner basis
+	return out, nil
+}
+
+// Saturation returns the saturation of id with respect to other. That is, the
+// union of the ideals (id : other^n) for n=1,2,.... The generators of the
+// result form a reduced Gröbner basis.
+//
+// The saturation is found by computing iterated colon ideals until the chain
+// stabilizes.
+//
+// If the ideals are defined over different rings, an InputIncompatible-error is
+// returned. If they are defined over a quotient ring, an InputValue-error is
+// returned.
+func (id *Ideal) Saturation(other *Ideal) (*Ideal, error) {
+	const op = "Computing saturation"
+
+	current, err := id.Colon(other)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	for {
+		next, err := current.Colon(other)
+		if err != nil {
+			return nil, errors.Wrap(op, errors.Inherit, err)
+		}
+		if current.containsIdeal(next) {
+			return current, nil
+		}
+		current = next
+	}
+}
+
+// EliminationPolynomial returns the monic generator of the elimination ideal of
+// id consisting of the polynomials in a single variable. The variable is X if
+// variable is 0 and Y if variable is 1. If id contains no non-zero polynomial
+// in this variable, the zero polynomial is returned.
+//
+// The generator is found from a lexicographical Gröbner basis in which the
+// other variable is the largest [CLO15; Section 3.1]. For zero-dimensional
+// ideals, this basis is computed using FGLM.
+//
+// If variable is neither 0 nor 1, or if id is defined over a quotient ring, an
+// InputValue-error is returned.
+func (id *Ideal) EliminationPolynomial(variable int) (*univariate.Polynomial, error) {
+	const op = "Computing elimination polynomial"
+
+	if variable != 0 && variable != 1 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Variable index must be 0 or 1, but received %d", variable,
+		)
+	}
+	r, err := id.polynomialRing(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// Eliminate the other variable by making it the largest
+	lexRing := DefRing(r.baseField, Lex(variable == 1))
+	lexRing.varNames = r.varNames
+
+	var gb *Ideal
+	if id.IsGroebner() {
+		gb = id
+	} else {
+		gb = id.GroebnerBasis()
+	}
+	var lexId *Ideal
+	if isZeroDimensional(gb.generators) {
+		if lexId, err = gb.FGLM(lexRing); err != nil {
+			return nil, errors.Wrap(op, errors.Inherit, err)
+		}
+	} else {
+		gens := make([]*Polynomial, len(id.generators))
+		for i, g := range id.generators {
+			gens[i] = lexRing.Polynomial(g.coefs)
+		}
+		if lexId, err = lexRing.NewIdeal(gens...); err != nil {
+			return nil, errors.Wrap(op, errors.Inherit, err)
+		}
+		lexId.algorithm = id.algorithm
+		lexId = lexId.GroebnerBasis()
+		_ = lexId.ReduceBasis() // Ignore error since lexId is a Gröbner basis
+	}
+
+	uRing := univariate.DefRing(r.baseField)
+	_ = uRing.SetVarName(r.varNames[variable]) // Variable names are non-empty
+	for _, g := range lexId.generators {
+		ld := g.Ld()
+		if ld[1-variable] != 0 {
+			continue
+		}
+		// The basis is reduced, so g is monic
+		coefs := make([]ff.Element, ld[variable]+1)
+		for i := range coefs {
+			coefs[i] = r.baseField.Zero()
+		}
+		for d, c := range g.coefs {
+			coefs[d[variable]] = c
+		}
+		return uRing.Polynomial(coefs), nil
+	}
+	return uRing.Zero(), nil
+}
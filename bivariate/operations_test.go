@@ -0,0 +1,261 @@
+package bivariate
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// randomIdeal returns an ideal of r generated by nGens random non-zero
+// polynomials.
+func randomIdeal(r *QuotientRing, nGens int) *Ideal {
+	gens := make([]*Polynomial, nGens)
+	for i := range gens {
+		gens[i] = randomPolynomial(r, 3, 3)
+		for gens[i].IsZero() {
+			gens[i] = randomPolynomial(r, 3, 3)
+		}
+	}
+	id, _ := r.NewIdeal(gens...)
+	return id
+}
+
+func TestSumAndProduct(t *testing.T) {
+	fieldLoop(func(field ff.Field) {
+		r := DefRing(field, DegRevLex(true))
+		for rep := 0; rep < 5; rep++ {
+			id1, id2 := randomIdeal(r, 2), randomIdeal(r, 2)
+
+			sum, err := id1.Sum(id2)
+			if err != nil {
+				t.Fatalf("Sum of %v and %v failed: %v", id1, id2, err)
+			}
+			prod, err := id1.Product(id2)
+			if err != nil {
+				t.Fatalf("Product of %v and %v failed: %v", id1, id2, err)
+			}
+			for _, f := range id1.generators {
+				if !sum.Contains(f) {
+					t.Errorf("%v is not in the sum %v", f, sum)
+				}
+				for _, g := range id2.generators {
+					if !sum.Contains(g) {
+						t.Errorf("%v is not in the sum %v", g, sum)
+					}
+					if !prod.Contains(f.Times(g)) {
+						t.Errorf("%v is not in the product %v", f.Times(g), prod)
+					}
+				}
+			}
+		}
+	}, 3)
+}
+
+func TestIntersection(t *testing.T) {
+	fieldLoop(func(field ff.Field) {
+		for _, ord := range []Order{Lex(true), DegRevLex(false), WDegLex(2, 3, true)} {
+			r := DefRing(field, ord)
+			for rep := 0; rep < 3; rep++ {
+				id1, id2 := randomIdeal(r, 2), randomIdeal(r, 2)
+				inter, err := id1.Intersection(id2)
+				if err != nil {
+					t.Fatalf("Intersection of %v and %v failed: %v", id1, id2, err)
+				}
+				if !inter.IsReduced() {
+					t.Errorf("Intersection %v is not a reduced Gröbner basis", inter)
+				}
+				for _, g := range inter.generators {
+					if !id1.Contains(g) || !id2.Contains(g) {
+						t.Errorf("%v is not in both %v and %v", g, id1, id2)
+					}
+				}
+				for _, f := range id1.generators {
+					for _, g := range id2.generators {
+						if !inter.Contains(f.Times(g)) {
+							t.Errorf(
+								"%v is not in the intersection %v",
+								f.Times(g), inter,
+							)
+						}
+					}
+				}
+			}
+		}
+	}, 3)
+}
+
+func TestColonAndSaturation(t *testing.T) {
+	field := defineField(7, t)
+	r := DefRing(field, DegRevLex(true))
+	monomialIdeal := func(degs ...[2]uint) *Ideal {
+		gens := make([]*Polynomial, len(degs))
+		for i, d := range degs {
+			gens[i] = r.PolynomialFromUnsigned(map[[2]uint]uint{d: 1})
+		}
+		id, _ := r.NewIdeal(gens...)
+		return id
+	}
+
+	tests := []struct {
+		id, other    *Ideal
+		colon, satur *Ideal
+		intersection *Ideal
+	}{
+		{
+			id:           monomialIdeal([2]uint{2, 1}, [2]uint{1, 2}),
+			other:        monomialIdeal([2]uint{1, 0}),
+			colon:        monomialIdeal([2]uint{1, 1}, [2]uint{0, 2}),
+			satur:        monomialIdeal([2]uint{0, 1}),
+			intersection: monomialIdeal([2]uint{2, 1}, [2]uint{1, 2}),
+		},
+		{
+			id:           monomialIdeal([2]uint{2, 2}),
+			other:        monomialIdeal([2]uint{1, 1}),
+			colon:        monomialIdeal([2]uint{1, 1}),
+			satur:        monomialIdeal([2]uint{0, 0}),
+			intersection: monomialIdeal([2]uint{2, 2}),
+		},
+		{
+			id:           monomialIdeal([2]uint{3, 0}, [2]uint{0, 1}),
+			other:        monomialIdeal([2]uint{1, 0}, [2]uint{0, 1}),
+			colon:        monomialIdeal([2]uint{2, 0}, [2]uint{0, 1}),
+			satur:        monomialIdeal([2]uint{0, 0}),
+			intersection: monomialIdeal([2]uint{3, 0}, [2]uint{0, 1}),
+		},
+	}
+
+	for _, test := range tests {
+		colon, err := test.id.Colon(test.other)
+		if err != nil {
+			t.Errorf("Colon of %v and %v failed: %v", test.id, test.other, err)
+		} else if !equalGenerators(colon, test.colon) {
+			t.Errorf(
+				"(%v : %v) = %v, but expected %v",
+				test.id.ShortString(), test.other.ShortString(),
+				colon.ShortString(), test.colon.ShortString(),
+			)
+		}
+
+		satur, err := test.id.Saturation(test.other)
+		if err != nil {
+			t.Errorf("Saturation of %v by %v failed: %v", test.id, test.other, err)
+		} else if !equalGenerators(satur, test.satur) {
+			t.Errorf(
+				"Saturation of %v by %v is %v, but expected %v",
+				test.id.ShortString(), test.other.ShortString(),
+				satur.ShortString(), test.satur.ShortString(),
+			)
+		}
+
+		inter, err := test.id.Intersection(test.other)
+		if err != nil {
+			t.Errorf("Intersection of %v and %v failed: %v", test.id, test.other, err)
+		} else if !equalGenerators(inter, test.intersection) {
+			t.Errorf(
+				"Intersection of %v and %v is %v, but expected %v",
+				test.id.ShortString(), test.other.ShortString(),
+				inter.ShortString(), test.intersection.ShortString(),
+			)
+		}
+	}
+}
+
+func TestColonRandom(t *testing.T) {
+	fieldLoop(func(field ff.Field) {
+		r := DefRing(field, DegRevLex(true))
+		for rep := 0; rep < 3; rep++ {
+			id1, id2 := randomIdeal(r, 2), randomIdeal(r, 1)
+			colon, err := id1.Colon(id2)
+			if err != nil {
+				t.Fatalf("Colon of %v and %v failed: %v", id1, id2, err)
+			}
+			for _, f := range colon.generators {
+				for _, g := range id2.generators {
+					if !id1.Contains(f.Times(g)) {
+						t.Errorf(
+							"%v is in (%v : %v), but %v is not in %v",
+							f, id1, id2, f.Times(g), id1,
+						)
+					}
+				}
+			}
+			for _, f := range id1.generators {
+				if !colon.Contains(f) {
+					t.Errorf("%v is not in (%v : %v)", f, id1, id2)
+				}
+			}
+		}
+	}, 3)
+}
+
+func TestEliminationPolynomial(t *testing.T) {
+	field := defineField(7, t)
+	for _, ord := range []Order{Lex(true), Lex(false), DegRevLex(true)} {
+		r := DefRing(field, ord)
+		if err := r.SetVarNames([2]string{"u", "v"}); err != nil {
+			t.Fatalf("Failed to set variable names: %v", err)
+		}
+
+		tests := []struct {
+			gens     []string
+			expected [2]string
+		}{
+			{[]string{"u^2 - v", "v^2 - 1"}, [2]string{"u^4 + 6", "v^2 + 6"}},
+			{[]string{"u^2 - v", "u*v - 1"}, [2]string{"u^3 + 6", "v^3 + 6"}},
+			{[]string{"u*v - 1"}, [2]string{"0", "0"}},
+			{[]string{"u^2 + 2u*v", "v^3 + u"}, [2]string{"u^4 + 6u^2", "v^6 + 5v^4"}},
+		}
+		for _, test := range tests {
+			gens := make([]*Polynomial, len(test.gens))
+			for i, s := range test.gens {
+				gens[i], _ = r.PolynomialFromString(s)
+			}
+			id, _ := r.NewIdeal(gens...)
+
+			for v := 0; v < 2; v++ {
+				f, err := id.EliminationPolynomial(v)
+				if err != nil {
+					t.Errorf("Elimination of %v failed: %v", id, err)
+				} else if f.String() != test.expected[v] {
+					t.Errorf(
+						"Elimination polynomial %d of %v is %v, but expected %s",
+						v, id, f, test.expected[v],
+					)
+				}
+			}
+		}
+	}
+}
+
+func TestOperationsErrors(t *testing.T) {
+	field := defineField(5, t)
+	r1 := DefRing(field, Lex(true))
+	r2 := DefRing(field, Lex(true))
+	id1 := randomIdeal(r1, 2)
+	id2 := randomIdeal(r2, 2)
+
+	_, err := id1.Sum(id2)
+	assertError(t, err, errors.InputIncompatible, "Sum of ideals from different rings")
+	_, err = id1.Product(id2)
+	assertError(t, err, errors.InputIncompatible, "Product of ideals from different rings")
+	_, err = id1.Intersection(id2)
+	assertError(t, err, errors.InputIncompatible, "Intersection of ideals from different rings")
+	_, err = id1.Colon(id2)
+	assertError(t, err, errors.InputIncompatible, "Colon of ideals from different rings")
+	_, err = id1.Saturation(id2)
+	assertError(t, err, errors.InputIncompatible, "Saturation of ideals from different rings")
+	_, err = id1.EliminationPolynomial(2)
+	assertError(t, err, errors.InputValue, "Elimination with variable index 2")
+
+	if id1.Contains(r2.Zero()) {
+		t.Errorf("%v reportedly contains polynomial from a different ring", id1)
+	}
+
+	qr := hermitianRing(2, t)
+	qId := randomIdeal(qr, 2)
+	_, err = qId.Sum(qId)
+	assertError(t, err, errors.InputValue, "Sum of ideals of quotient ring")
+	_, err = qId.EliminationPolynomial(0)
+	assertError(t, err, errors.InputValue, "Elimination in quotient ring")
+}
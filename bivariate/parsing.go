@@ -7,6 +7,7 @@ import (
 
 	"github.com/ReneBoedker/algobra/errors"
 	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/internal/parsing"
 )
 
 type monomialMatch struct {
@@ -80,7 +81,7 @@ func (m *monomialMatch) degreesAndCoef(op errors.Op) (deg [2]uint, coef ff.Eleme
 	if m.coef == "" {
 		coef = m.qr.baseField.One()
 	} else {
-		coef, err = m.qr.baseField.ElementFromString(strings.Trim(m.coef, "()"))
+		coef, err = m.qr.baseField.ElementFromString(parsing.TrimParens(m.coef))
 		if err != nil {
 			return deg, coef, errors.Wrap(op, errors.Conversion, err)
 		}
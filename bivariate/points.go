@@ -0,0 +1,113 @@
+package bivariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+// bruteForceCard is the largest field cardinality for which rational points are
+// found by evaluating the generators in every pair of field elements.
+const bruteForceCard = 16
+
+// RationalPoints returns the points with coordinates in the base field in which
+// all generators of id vanish. That is, the variety of id over the base field.
+// The points are returned in no particular order.
+//
+// The points are found by elimination. Let p be the generator of the
+// polynomials in id that only depend on X. For each root a of p in the base
+// field, the common roots b of f(a,Y) for the generators f of id give the
+// points (a,b). If id does not contain any non-zero polynomial in X, the roles
+// of X and Y are interchanged. If neither variable can be eliminated, every
+// element of the field is tried as the first coordinate.
+//
+// For very small fields, the generators are instead evaluated in all points.
+//
+// If id is defined over a quotient ring, an InputValue-error is returned.
+func (id *Ideal) RationalPoints() ([][2]ff.Element, error) {
+	const op = "Computing rational points"
+
+	r, err := id.polynomialRing(op)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([][2]ff.Element, 0)
+	if r.baseField.Card() <= bruteForceCard {
+		elems := r.baseField.Elements()
+		for _, a := range elems {
+		outer:
+			for _, b := range elems {
+				p := [2]ff.Element{a, b}
+				for _, g := range id.generators {
+					if g.Eval(p).IsNonzero() {
+						continue outer
+					}
+				}
+				out = append(out, p)
+			}
+		}
+		return out, nil
+	}
+
+	// Find the candidates for the first coordinate
+	first := 0
+	elim, err := id.EliminationPolynomial(first)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	if elim.IsZero() {
+		first = 1
+		if elim, err = id.EliminationPolynomial(first); err != nil {
+			return nil, errors.Wrap(op, errors.Inherit, err)
+		}
+	}
+	var candidates []ff.Element
+	if elim.IsZero() {
+		candidates = r.baseField.Elements()
+	} else if candidates, err = elim.Roots(); err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	uRing := univariate.DefRing(r.baseField)
+	for _, a := range candidates {
+		roots, err := id.substitutedRoots(uRing, first, a)
+		if err != nil {
+			return nil, errors.Wrap(op, errors.Inherit, err)
+		}
+		for _, b := range roots {
+			p := [2]ff.Element{}
+			p[first], p[1-first] = a, b
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+// substitutedRoots returns the common roots of the univariate polynomials
+// obtained from the generators of id by substituting a for the variable with
+// the given index.
+func (id *Ideal) substitutedRoots(uRing *univariate.QuotientRing, variable int, a ff.Element) ([]ff.Element, error) {
+	field := uRing.BaseField()
+
+	polys := make([]*univariate.Polynomial, 0, len(id.generators))
+	for _, g := range id.generators {
+		f := uRing.Zero()
+		for d, c := range g.coefs {
+			f.IncrementCoef(int(d[1-variable]), a.Pow(d[variable]).Mult(c))
+		}
+		if f.IsNonzero() {
+			polys = append(polys, f)
+		}
+	}
+
+	if len(polys) == 0 {
+		// All generators vanish on the line
+		return field.Elements(), nil
+	}
+	g, err := univariate.Gcd(polys[0], polys[1:]...)
+	if err != nil {
+		return nil, err
+	}
+	return g.Roots()
+}
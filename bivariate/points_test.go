@@ -0,0 +1,119 @@
+package bivariate
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// pointKeys returns the string representations of the points, and reports
+// whether any point occurs more than once.
+func pointKeys(points [][2]ff.Element) (keys map[[2]string]bool, repeated bool) {
+	keys = make(map[[2]string]bool, len(points))
+	for _, p := range points {
+		k := [2]string{p[0].String(), p[1].String()}
+		repeated = repeated || keys[k]
+		keys[k] = true
+	}
+	return keys, repeated
+}
+
+// bruteForcePoints returns the points in which all generators of id vanish by
+// evaluating in every point.
+func bruteForcePoints(id *Ideal) map[[2]string]bool {
+	elems := id.baseField.Elements()
+	out := make(map[[2]string]bool)
+	for _, a := range elems {
+	outer:
+		for _, b := range elems {
+			for _, g := range id.generators {
+				if g.Eval([2]ff.Element{a, b}).IsNonzero() {
+					continue outer
+				}
+			}
+			out[[2]string{a.String(), b.String()}] = true
+		}
+	}
+	return out
+}
+
+func TestRationalPoints(t *testing.T) {
+	// Include fields that are too large for the brute force approach
+	for _, card := range []uint{3, 4, 5, 17, 19, 25, 32} {
+		field := defineField(card, t)
+		r := DefRing(field, DegRevLex(true))
+		ideals := make([]*Ideal, 0)
+		for rep := 0; rep < 5; rep++ {
+			ideals = append(ideals, randomIdeal(r, 1), randomIdeal(r, 2))
+		}
+		// Ideals whose varieties are lines
+		for _, gens := range [][]map[[2]uint]int{
+			{{{1, 0}: 1, {0, 0}: -1}},
+			{{{0, 1}: 2, {0, 0}: 1}, {{0, 2}: 2, {0, 1}: 1}},
+		} {
+			polys := make([]*Polynomial, len(gens))
+			for i, g := range gens {
+				polys[i] = r.PolynomialFromSigned(g)
+			}
+			if id, err := r.NewIdeal(polys...); err == nil {
+				ideals = append(ideals, id)
+			}
+		}
+
+		for _, id := range ideals {
+			points, err := id.RationalPoints()
+			if err != nil {
+				t.Errorf("RationalPoints of %v returned an error: %v", id, err)
+				continue
+			}
+			found, repeated := pointKeys(points)
+			if repeated {
+				t.Errorf("RationalPoints of %v contains repetitions", id)
+			}
+			expected := bruteForcePoints(id)
+			if len(found) != len(expected) {
+				t.Errorf(
+					"RationalPoints of %v returned %d points, but expected %d",
+					id, len(found), len(expected),
+				)
+				continue
+			}
+			for k := range expected {
+				if !found[k] {
+					t.Errorf("RationalPoints of %v did not contain %v", id, k)
+				}
+			}
+		}
+	}
+}
+
+func TestRationalPointsHermitian(t *testing.T) {
+	for _, q := range []uint{2, 3, 4, 5, 7} {
+		field := defineField(q*q, t)
+		r := DefRing(field, WDegLex(q, q+1, false))
+		id, _ := r.NewIdeal(r.PolynomialFromSigned(map[[2]uint]int{
+			{q + 1, 0}: 1,
+			{0, q}:     -1,
+			{0, 1}:     -1,
+		}))
+
+		points, err := id.RationalPoints()
+		if err != nil {
+			t.Errorf("RationalPoints of %v returned an error: %v", id, err)
+			continue
+		}
+		if found, repeated := pointKeys(points); repeated || len(found) != int(q*q*q) {
+			t.Errorf(
+				"The Hermitian curve over GF(%d) has %d distinct rational "+
+					"points, but expected %d", q*q, len(found), q*q*q,
+			)
+		}
+	}
+}
+
+func TestRationalPointsErrors(t *testing.T) {
+	qr := hermitianRing(2, t)
+	_, err := randomIdeal(qr, 2).RationalPoints()
+	assertError(t, err, errors.InputValue, "RationalPoints of ideal of quotient ring")
+}
@@ -90,6 +90,11 @@ func (r *QuotientRing) VarNames() [2]string {
 	return r.varNames
 }
 
+// BaseField returns the field over which the polynomials of r are defined.
+func (r *QuotientRing) BaseField() ff.Field {
+	return r.baseField
+}
+
 // Quotient defines the quotient of the given ring modulo the input ideal.
 //
 // The return type is a new QuotientRing-object
@@ -0,0 +1,144 @@
+package bivariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+// YRoots returns the polynomials p in X of degree less than degBound such that
+// f(X,p(X)) is zero. Equivalently, Y-p(X) divides f. The roots are returned in
+// no particular order.
+//
+// The roots are found using the algorithm of Roth and Ruckenstein, which
+// determines the coefficients of p one at a time. If f is divisible by exactly
+// X^s, then the constant term of p is a root of the univariate polynomial
+// f(0,Y)/X^s. For each such root a, the remaining coefficients are found
+// recursively from f(X,XY+a)/X^s. The number of branches is bounded by the
+// degree of f in Y.
+//
+// If f is defined over a quotient ring or f is the zero polynomial, an
+// InputValue-error is returned.
+func (f *Polynomial) YRoots(degBound uint) ([]*univariate.Polynomial, error) {
+	const op = "Computing Y-roots"
+
+	if f.err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, f.err)
+	}
+	if f.baseRing.id != nil {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Polynomial %v is defined over a quotient ring", f,
+		)
+	}
+	if f.IsZero() {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Every polynomial is a root of the zero polynomial",
+		)
+	}
+
+	degY := uint(0)
+	for d := range f.coefs {
+		if d[1] > degY {
+			degY = d[1]
+		}
+	}
+
+	rr := &rothRuckenstein{
+		uRing:    univariate.DefRing(f.BaseField()),
+		binom:    f.baseRing.binomials(degY),
+		degBound: degBound,
+		prefix:   make([]ff.Element, 0, degBound),
+		out:      make([]*univariate.Polynomial, 0),
+	}
+	if err := rr.search(f.coefs); err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	return rr.out, nil
+}
+
+// rothRuckenstein holds the state of the depth-first search in YRoots.
+type rothRuckenstein struct {
+	uRing    *univariate.QuotientRing
+	binom    [][]ff.Element
+	degBound uint
+	prefix   []ff.Element
+	out      []*univariate.Polynomial
+}
+
+// search appends the roots with coefficients starting with rr.prefix to rr.out.
+// The coefficients of the remaining part are the roots of the polynomial with
+// the given coefficients.
+func (rr *rothRuckenstein) search(coefs map[[2]uint]ff.Element) error {
+	// Divide by the largest possible power of X
+	s := ^uint(0)
+	for d := range coefs {
+		if d[0] < s {
+			s = d[0]
+		}
+	}
+	if s > 0 {
+		shifted := make(map[[2]uint]ff.Element, len(coefs))
+		for d, c := range coefs {
+			shifted[[2]uint{d[0] - s, d[1]}] = c
+		}
+		coefs = shifted
+	}
+
+	if uint(len(rr.prefix)) == rr.degBound {
+		// The prefix is a root if the remaining polynomial vanishes in Y=0
+		for d := range coefs {
+			if d[1] == 0 {
+				return nil
+			}
+		}
+		rr.out = append(rr.out, rr.uRing.Polynomial(rr.prefix))
+		return nil
+	}
+
+	// The candidates for the next coefficient are the roots of f(0,Y)
+	g := rr.uRing.Zero()
+	for d, c := range coefs {
+		if d[0] == 0 {
+			g.SetCoef(int(d[1]), c)
+		}
+	}
+	roots, err := g.Roots()
+	if err != nil {
+		return err
+	}
+
+	for _, a := range roots {
+		// Substitute XY+a for Y
+		next := make(map[[2]uint]ff.Element, len(coefs))
+		for d, c := range coefs {
+			aPow := rr.uRing.BaseField().One()
+			for l := d[1]; ; l-- {
+				term := rr.binom[d[1]][l].Times(aPow).Mult(c)
+				deg := [2]uint{d[0] + l, l}
+				if e, ok := next[deg]; ok {
+					e.Add(term)
+				} else {
+					next[deg] = term
+				}
+				if l == 0 {
+					break
+				}
+				aPow.Mult(a)
+			}
+		}
+		for d, c := range next {
+			if c.IsZero() {
+				delete(next, d)
+			}
+		}
+
+		rr.prefix = append(rr.prefix, a)
+		if err := rr.search(next); err != nil {
+			return err
+		}
+		rr.prefix = rr.prefix[:len(rr.prefix)-1]
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package bivariate
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+// yMinus returns the polynomial Y-p(X).
+func yMinus(r *QuotientRing, p *univariate.Polynomial) *Polynomial {
+	coefs := map[[2]uint]ff.Element{{0, 1}: r.baseField.One()}
+	for i := 0; i <= p.Ld(); i++ {
+		coefs[[2]uint{uint(i), 0}] = p.Coef(i).Neg()
+	}
+	return r.Polynomial(coefs)
+}
+
+func TestYRoots(t *testing.T) {
+	fieldLoop(func(field ff.Field) {
+		ring := DefRing(field, DegLex(true))
+		uRing := univariate.DefRing(field)
+		for rep := 0; rep < 10; rep++ {
+			degBound := prg.Intn(4)
+			expected := make(map[string]bool)
+
+			// Multiply a random polynomial in X by factors Y-p(X)
+			f := ring.Zero()
+			for f.IsZero() {
+				f = randomPolynomial(ring, 3, 3)
+				for d := range f.coefs {
+					if d[1] > 0 {
+						f.removeCoef(d)
+					}
+				}
+			}
+			for i := prg.Intn(4); i > 0; i-- {
+				coefs := make([]ff.Element, degBound)
+				for j := range coefs {
+					coefs[j] = field.RandElement()
+				}
+				p := uRing.Polynomial(coefs)
+				f.Mult(yMinus(ring, p))
+				expected[p.String()] = true
+			}
+
+			// Add factors without roots of degree less than degBound. Note that X is
+			// not a square
+			switch prg.Intn(3) {
+			case 1:
+				f.Mult(ring.PolynomialFromSigned(map[[2]uint]int{
+					{0, 2}: 1, {1, 0}: -1,
+				}))
+			case 2:
+				f.Mult(ring.PolynomialFromSigned(map[[2]uint]int{
+					{0, 1}: 1, {uint(degBound), 0}: -1,
+				}))
+			}
+
+			roots, err := f.YRoots(uint(degBound))
+			if err != nil {
+				t.Errorf("YRoots returned an error: %q", err)
+				continue
+			}
+			found := make(map[string]bool, len(roots))
+			for _, p := range roots {
+				if found[p.String()] {
+					t.Errorf("YRoots of %v returned %v more than once", f, p)
+				}
+				found[p.String()] = true
+			}
+			if len(found) != len(expected) {
+				t.Errorf("YRoots of %v with bound %d returned %v, but expected "+
+					"%v", f, degBound, found, expected)
+				continue
+			}
+			for s := range expected {
+				if !found[s] {
+					t.Errorf("YRoots of %v with bound %d returned %v, but "+
+						"expected %v", f, degBound, found, expected)
+					break
+				}
+			}
+		}
+	})
+}
+
+func TestYRootsErrors(t *testing.T) {
+	field := defineField(7, t)
+	ring := DefRing(field, Lex(true))
+
+	_, err := ring.Zero().YRoots(3)
+	assertError(t, err, errors.InputValue, "YRoots of zero polynomial")
+
+	id, _ := ring.NewIdeal(ring.PolynomialFromSigned(map[[2]uint]int{{2, 0}: 1, {0, 0}: -1}))
+	qRing, _ := ring.Quotient(id)
+	f := qRing.PolynomialFromSigned(map[[2]uint]int{{0, 1}: 1, {1, 0}: -1})
+	_, err = f.YRoots(3)
+	assertError(t, err, errors.InputValue, "YRoots over quotient ring")
+}
@@ -0,0 +1,175 @@
+package bivariate
+
+import (
+	"sort"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// IsFiniteDimensional determines whether r has finite dimension as a vector
+// space over its base field. This is the case exactly when r is the quotient
+// modulo a zero-dimensional ideal.
+func (r *QuotientRing) IsFiniteDimensional() bool {
+	return r.id != nil && isZeroDimensional(r.id.generators)
+}
+
+// StandardMonomials returns the degrees of the standard monomials of r. That
+// is, the monomials that are not divisible by the leading monomial of any
+// element of the Gröbner basis defining r. This set is also known as the
+// footprint of the ideal, and the standard monomials form a basis of r as a
+// vector space over the base field.
+//
+// The degrees are sorted in increasing order with respect to the order of r.
+//
+// If r is not finite-dimensional, an InputValue-error is returned.
+func (r *QuotientRing) StandardMonomials() ([][2]uint, error) {
+	const op = "Computing standard monomials"
+
+	if !r.IsFiniteDimensional() {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"%v is not finite-dimensional", r,
+		)
+	}
+
+	// Since r is finite-dimensional, there are leading monomials X^a and Y^b
+	lds := make([][2]uint, len(r.id.generators))
+	bound := [2]uint{^uint(0), ^uint(0)}
+	for i, g := range r.id.generators {
+		lds[i] = g.Ld()
+		for j := 0; j < 2; j++ {
+			if lds[i][1-j] == 0 && lds[i][j] < bound[j] {
+				bound[j] = lds[i][j]
+			}
+		}
+	}
+
+	out := make([][2]uint, 0)
+	for i := uint(0); i < bound[0]; i++ {
+	outer:
+		for j := uint(0); j < bound[1]; j++ {
+			for _, ld := range lds {
+				if degDivides(ld, [2]uint{i, j}) {
+					continue outer
+				}
+			}
+			out = append(out, [2]uint{i, j})
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return r.ord(out[i], out[j]) < 0
+	})
+	return out, nil
+}
+
+// Dimension returns the dimension of r as a vector space over its base field.
+// This is the number of standard monomials.
+//
+// If r is not finite-dimensional, an InputValue-error is returned.
+func (r *QuotientRing) Dimension() (int, error) {
+	const op = "Computing dimension"
+
+	std, err := r.StandardMonomials()
+	if err != nil {
+		return 0, errors.Wrap(op, errors.Inherit, err)
+	}
+	return len(std), nil
+}
+
+// Coordinates returns the coordinate vector of f with respect to the basis of
+// standard monomials of its ring. The entries correspond to the degrees
+// returned by StandardMonomials.
+//
+// If the ring of f is not finite-dimensional, an InputValue-error is returned.
+func (f *Polynomial) Coordinates() ([]ff.Element, error) {
+	const op = "Computing coordinate vector"
+
+	if f.err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, f.err)
+	}
+
+	std, err := f.baseRing.StandardMonomials()
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	// Polynomials are reduced, so f is supported on the standard monomials
+	out := make([]ff.Element, len(std))
+	for i, d := range std {
+		out[i] = f.Coef(d)
+	}
+	return out, nil
+}
+
+// PolynomialFromCoordinates returns the polynomial with the given coordinate
+// vector with respect to the basis of standard monomials of r. See also
+// Coordinates.
+//
+// If r is not finite-dimensional, or if the length of coords differs from the
+// dimension of r, an InputValue-error is returned.
+func (r *QuotientRing) PolynomialFromCoordinates(coords []ff.Element) (*Polynomial, error) {
+	const op = "Defining polynomial from coordinates"
+
+	std, err := r.StandardMonomials()
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	if len(coords) != len(std) {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Received %d coordinates, but %v has dimension %d",
+			len(coords), r, len(std),
+		)
+	}
+
+	f := r.zeroWithCap(len(std))
+	for i, d := range std {
+		f.SetCoef(d, coords[i])
+	}
+	return f, nil
+}
+
+// MultiplicationMatrix returns the matrix of the linear map on r given by
+// multiplication by a variable. The variable is X if variable is 0 and Y if
+// variable is 1. The matrix is given with respect to the basis of standard
+// monomials, such that column j contains the coordinates of the product of the
+// variable and the j'th standard monomial. In other words, the matrix must be
+// multiplied from the left onto coordinate vectors.
+//
+// The eigenvalues of the multiplication matrices are the coordinates of the
+// points in the variety of the ideal defining r.
+//
+// If r is not finite-dimensional, or if variable is neither 0 nor 1, an
+// InputValue-error is returned.
+func (r *QuotientRing) MultiplicationMatrix(variable int) ([][]ff.Element, error) {
+	const op = "Computing multiplication matrix"
+
+	if variable != 0 && variable != 1 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Variable index must be 0 or 1, but received %d", variable,
+		)
+	}
+
+	std, err := r.StandardMonomials()
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	out := make([][]ff.Element, len(std))
+	for i := range out {
+		out[i] = make([]ff.Element, len(std))
+	}
+	for j, d := range std {
+		d[variable]++
+		prod := r.Zero()
+		prod.SetCoefPtr(d, r.baseField.One())
+		prod.reduce()
+		for i, e := range std {
+			out[i][j] = prod.Coef(e)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,150 @@
+package bivariate
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// hermitianRing returns the quotient of the ring modulo the ideal of the
+// Hermitian curve over the field of q^2 elements together with the field
+// equations.
+func hermitianRing(q uint, t *testing.T) *QuotientRing {
+	field := defineField(q*q, t)
+	r := DefRing(field, WDegLex(q, q+1, false))
+	id, _ := r.NewIdeal(
+		r.PolynomialFromSigned(map[[2]uint]int{
+			{q + 1, 0}: 1,
+			{0, q}:     -1,
+			{0, 1}:     -1,
+		}),
+		r.PolynomialFromSigned(map[[2]uint]int{
+			{q * q, 0}: 1,
+			{1, 0}:     -1,
+		}),
+		r.PolynomialFromSigned(map[[2]uint]int{
+			{0, q * q}: 1,
+			{0, 1}:     -1,
+		}),
+	)
+	qr, err := r.Quotient(id)
+	if err != nil {
+		t.Fatalf("Failed to define quotient ring: %v", err)
+	}
+	return qr
+}
+
+func TestStandardMonomials(t *testing.T) {
+	fieldLoop(func(field ff.Field) {
+		q := field.Card()
+		r := DefRing(field, DegRevLex(true))
+		id, _ := r.NewIdeal(
+			r.PolynomialFromSigned(map[[2]uint]int{{q, 0}: 1, {1, 0}: -1}),
+			r.PolynomialFromSigned(map[[2]uint]int{{0, q}: 1, {0, 1}: -1}),
+		)
+		qr, _ := r.Quotient(id)
+
+		std, err := qr.StandardMonomials()
+		if err != nil {
+			t.Fatalf("StandardMonomials failed for %v: %v", qr, err)
+		}
+		if uint(len(std)) != q*q {
+			t.Errorf("%v has %d standard monomials, but expected %d", qr, len(std), q*q)
+		}
+		for i, d := range std {
+			if d[0] >= q || d[1] >= q {
+				t.Errorf("%v is not a standard monomial of %v", d, qr)
+			}
+			if i > 0 && r.ord(std[i-1], d) >= 0 {
+				t.Errorf("Standard monomials %v are not sorted", std)
+			}
+		}
+	}, 2)
+
+	// The Hermitian curve has q^3 rational points
+	for _, q := range []uint{2, 3} {
+		qr := hermitianRing(q, t)
+		if dim, err := qr.Dimension(); err != nil || uint(dim) != q*q*q {
+			t.Errorf("%v has dimension %d, but expected %d (err = %v)", qr, dim, q*q*q, err)
+		}
+	}
+}
+
+func TestCoordinates(t *testing.T) {
+	qr := hermitianRing(3, t)
+	std, _ := qr.StandardMonomials()
+	mx, err := qr.MultiplicationMatrix(0)
+	if err != nil {
+		t.Fatalf("MultiplicationMatrix failed: %v", err)
+	}
+	my, _ := qr.MultiplicationMatrix(1)
+	x := qr.PolynomialFromUnsigned(map[[2]uint]uint{{1, 0}: 1})
+	y := qr.PolynomialFromUnsigned(map[[2]uint]uint{{0, 1}: 1})
+
+	mulVec := func(m [][]ff.Element, v []ff.Element) []ff.Element {
+		out := make([]ff.Element, len(m))
+		for i := range m {
+			out[i] = qr.baseField.Zero()
+			for j := range v {
+				out[i].Add(m[i][j].Times(v[j]))
+			}
+		}
+		return out
+	}
+	equalVec := func(a, b []ff.Element) bool {
+		for i := range a {
+			if !a[i].Equal(b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for rep := 0; rep < 10; rep++ {
+		f := randomPolynomial(qr, 15, 10)
+		coords, err := f.Coordinates()
+		if err != nil {
+			t.Fatalf("Coordinates failed: %v", err)
+		}
+		if len(coords) != len(std) {
+			t.Errorf("Coordinate vector has length %d, but expected %d", len(coords), len(std))
+		}
+		if g, _ := qr.PolynomialFromCoordinates(coords); !g.Equal(f) {
+			t.Errorf("PolynomialFromCoordinates(Coordinates(%v)) = %v", f, g)
+		}
+
+		xf, _ := f.Times(x).Coordinates()
+		if !equalVec(mulVec(mx, coords), xf) {
+			t.Errorf("Multiplication matrix for X does not match multiplication of %v", f)
+		}
+		yf, _ := f.Times(y).Coordinates()
+		if !equalVec(mulVec(my, coords), yf) {
+			t.Errorf("Multiplication matrix for Y does not match multiplication of %v", f)
+		}
+	}
+}
+
+func TestStandardMonomialsErrors(t *testing.T) {
+	field := defineField(5, t)
+	r := DefRing(field, Lex(true))
+
+	_, err := r.StandardMonomials()
+	assertError(t, err, errors.InputValue, "StandardMonomials of polynomial ring")
+	_, err = r.Zero().Coordinates()
+	assertError(t, err, errors.InputValue, "Coordinates in polynomial ring")
+
+	id, _ := r.NewIdeal(r.PolynomialFromUnsigned(map[[2]uint]uint{{2, 0}: 1, {0, 1}: 1}))
+	qr, _ := r.Quotient(id)
+	if qr.IsFiniteDimensional() {
+		t.Errorf("%v is reported as finite-dimensional", qr)
+	}
+	_, err = qr.Dimension()
+	assertError(t, err, errors.InputValue, "Dimension of infinite-dimensional ring")
+
+	herm := hermitianRing(2, t)
+	_, err = herm.MultiplicationMatrix(2)
+	assertError(t, err, errors.InputValue, "MultiplicationMatrix with variable index 2")
+	_, err = herm.PolynomialFromCoordinates([]ff.Element{herm.baseField.One()})
+	assertError(t, err, errors.InputValue, "PolynomialFromCoordinates with wrong length")
+}
@@ -0,0 +1,362 @@
+package affinevariety
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ReneBoedker/algobra/bivariate"
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// Code is an affine variety code. Its codewords are the evaluations of the
+// linear combinations of a set of monomials in the rational points of a
+// bivariate ideal.
+type Code struct {
+	name      string
+	field     ff.Field
+	ring      *bivariate.QuotientRing
+	points    [][2]ff.Element
+	monomials [][2]uint
+	footprint [][2]uint
+	genMatrix [][]ff.Element
+}
+
+// New defines the affine variety code obtained by evaluating the linear
+// combinations of the given monomials in the rational points of id.
+//
+// Let q be the cardinality of the field, and let J be the sum of id and the
+// ideal generated by the field equations X^q-X and Y^q-Y. Then the monomials
+// must be standard monomials of J. That is, they must belong to the footprint
+// of J with respect to the monomial order of the ring of id. This ensures that
+// the dimension of the code equals the number of monomials.
+//
+// If the monomials are not distinct standard monomials, if no monomials are
+// given, or if id is defined over a quotient ring, an InputValue-error is
+// returned.
+func New(id *bivariate.Ideal, monomials [][2]uint) (*Code, error) {
+	const op = "Defining affine variety code"
+
+	c, err := newCode(id, monomials)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	c.name = "affine variety code"
+	return c, nil
+}
+
+// NewHermitian defines the Hermitian code over the field of q^2 elements
+// consisting of the evaluations of the polynomials of weighted degree at most s
+// in the q^3 rational points of the Hermitian curve X^(q+1)=Y^q+Y. Here, X and
+// Y have weights q and q+1, respectively.
+//
+// The Hermitian curve has genus g=q(q-1)/2. If 2g-2 < s < q^3, the code has
+// dimension s+1-g and minimum distance at least q^3-s.
+//
+// If the cardinality of the field is not a square, or if no monomials have
+// weighted degree at most s, an InputValue-error is returned.
+func NewHermitian(field ff.Field, s uint) (*Code, error) {
+	const op = "Defining Hermitian code"
+
+	q := uint(1)
+	for q*q < field.Card() {
+		q++
+	}
+	if q*q != field.Card() {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Cardinality of %v is not a square", field,
+		)
+	}
+
+	// Break ties such that Y^q is the leading monomial of the curve equation
+	r := bivariate.DefRing(field, bivariate.WDegLex(q, q+1, false))
+	id, _ := r.NewIdeal(r.PolynomialFromSigned(map[[2]uint]int{
+		{q + 1, 0}: 1,
+		{0, q}:     -1,
+		{0, 1}:     -1,
+	})) // Generator is non-zero
+
+	// The standard monomials are X^iY^j with j<q and i<q^2
+	monomials := make([][2]uint, 0)
+	for j := uint(0); j < q; j++ {
+		for i := uint(0); i < q*q && i*q+j*(q+1) <= s; i++ {
+			monomials = append(monomials, [2]uint{i, j})
+		}
+	}
+
+	c, err := newCode(id, monomials)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	c.name = "Hermitian code"
+	return c, nil
+}
+
+// newCode computes the points, the footprint, and the generator matrix of the
+// code defined by id and monomials.
+func newCode(id *bivariate.Ideal, monomials [][2]uint) (*Code, error) {
+	const op = "Defining affine variety code"
+
+	if len(monomials) == 0 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"At least one monomial is required",
+		)
+	}
+
+	r := id.Ring()
+	field := r.BaseField()
+	q := field.Card()
+	fieldEqs, _ := r.NewIdeal(
+		r.PolynomialFromSigned(map[[2]uint]int{{q, 0}: 1, {1, 0}: -1}),
+		r.PolynomialFromSigned(map[[2]uint]int{{0, q}: 1, {0, 1}: -1}),
+	) // Generators are non-zero
+	full, err := id.Sum(fieldEqs)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	// The rational points of id and full coincide
+	points, err := id.RationalPoints()
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	sortPoints(field, points)
+
+	qr, err := r.Quotient(full)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	footprint, err := qr.StandardMonomials()
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	// Check that the monomials are distinct standard monomials
+	isStandard := make(map[[2]uint]bool, len(footprint))
+	for _, m := range footprint {
+		isStandard[m] = true
+	}
+	seen := make(map[[2]uint]bool, len(monomials))
+	for _, m := range monomials {
+		if !isStandard[m] {
+			return nil, errors.New(
+				op, errors.InputValue,
+				"X^%dY^%d is not a standard monomial of %v",
+				m[0], m[1], full.ShortString(),
+			)
+		}
+		if seen[m] {
+			return nil, errors.New(
+				op, errors.InputValue,
+				"Monomial X^%dY^%d is repeated", m[0], m[1],
+			)
+		}
+		seen[m] = true
+	}
+
+	genMatrix := make([][]ff.Element, len(monomials))
+	for i, m := range monomials {
+		genMatrix[i] = make([]ff.Element, len(points))
+		for j, p := range points {
+			genMatrix[i][j] = p[0].Pow(m[0]).Mult(p[1].Pow(m[1]))
+		}
+	}
+
+	return &Code{
+		field:     field,
+		ring:      qr,
+		points:    points,
+		monomials: append([][2]uint{}, monomials...),
+		footprint: footprint,
+		genMatrix: genMatrix,
+	}, nil
+}
+
+// sortPoints sorts the points lexicographically with respect to the order in
+// which field.Elements lists the elements. This makes the order of the
+// coordinates independent of how the points were found.
+func sortPoints(field ff.Field, points [][2]ff.Element) {
+	index := make(map[string]int, field.Card())
+	for i, e := range field.Elements() {
+		index[e.String()] = i
+	}
+	sort.Slice(points, func(i, j int) bool {
+		for k := 0; k < 2; k++ {
+			a, b := index[points[i][k].String()], index[points[j][k].String()]
+			if a != b {
+				return a < b
+			}
+		}
+		return false
+	})
+}
+
+// String returns a string representation of c.
+func (c *Code) String() string {
+	return fmt.Sprintf(
+		"[%d, %d] %s over %v", c.Length(), c.Dimension(), c.name, c.field,
+	)
+}
+
+// Field returns the field over which c is defined.
+func (c *Code) Field() ff.Field {
+	return c.field
+}
+
+// Length returns the length of c. This is the number of rational points.
+func (c *Code) Length() int {
+	return len(c.points)
+}
+
+// Dimension returns the dimension of c. This is the number of monomials.
+func (c *Code) Dimension() int {
+	return len(c.monomials)
+}
+
+// Points returns a copy of the rational points in which the monomials are
+// evaluated. The i'th coordinate of a codeword corresponds to the i'th point.
+func (c *Code) Points() [][2]ff.Element {
+	out := make([][2]ff.Element, len(c.points))
+	for i, p := range c.points {
+		out[i] = [2]ff.Element{p[0].Copy(), p[1].Copy()}
+	}
+	return out
+}
+
+// Monomials returns a copy of the degrees of the monomials spanning c.
+func (c *Code) Monomials() [][2]uint {
+	return append([][2]uint{}, c.monomials...)
+}
+
+// GeneratorMatrix returns a copy of the generator matrix of c. The i'th row is
+// the evaluation of the i'th monomial in the points of c.
+func (c *Code) GeneratorMatrix() [][]ff.Element {
+	out := make([][]ff.Element, len(c.genMatrix))
+	for i, row := range c.genMatrix {
+		out[i] = make([]ff.Element, len(row))
+		for j, e := range row {
+			out[i][j] = e.Copy()
+		}
+	}
+	return out
+}
+
+// Encode returns the codeword corresponding to msg. That is, the evaluation of
+// the polynomial in which the coefficient of the i'th monomial is msg[i].
+//
+// If msg does not have length k, an InputValue-error is returned.
+func (c *Code) Encode(msg []ff.Element) ([]ff.Element, error) {
+	const op = "Encoding message"
+
+	if len(msg) != c.Dimension() {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Message has length %d, but expected length %d",
+			len(msg), c.Dimension(),
+		)
+	}
+
+	out := make([]ff.Element, c.Length())
+	for j := range out {
+		out[j] = c.field.Zero()
+	}
+	tmp := c.field.Zero()
+	for i, m := range msg {
+		if m.Err() != nil {
+			return nil, errors.Wrap(op, errors.Inherit, m.Err())
+		}
+		if m.IsZero() {
+			continue
+		}
+		for j, e := range c.genMatrix[i] {
+			out[j].Add(tmp.Prod(m, e))
+		}
+	}
+	return out, nil
+}
+
+// FootprintBound returns a lower bound on the minimum distance of c.
+//
+// Every non-zero polynomial f in the span of the monomials has a leading
+// monomial M among them, and the number of rational points where f does not
+// vanish is at least the number of standard monomials divisible by M (see
+// [Gei08]). The bound is the minimum of these numbers.
+func (c *Code) FootprintBound() int {
+	bound := c.Length()
+	for _, m := range c.monomials {
+		count := 0
+		for _, n := range c.footprint {
+			if m[0] <= n[0] && m[1] <= n[1] {
+				count++
+			}
+		}
+		if count < bound {
+			bound = count
+		}
+	}
+	return bound
+}
+
+// FengRaoBound returns a lower bound on the minimum distance of c, which is at
+// least as good as FootprintBound.
+//
+// Let f be a non-zero polynomial in the span of the monomials, and let M be its
+// leading monomial. A standard monomial K is said to be one-way well-behaving
+// with M if the leading monomial of M*K modulo the ideal is larger than that of
+// M'*K for every standard monomial M' smaller than M. The leading monomials of
+// f*K for such K are then known, and the weight of the evaluation of f is at
+// least the number of distinct leading monomials obtained in this way (see
+// [AG08]). The bound is the minimum of these numbers. For Hermitian codes, this
+// is at least the Goppa bound.
+func (c *Code) FengRaoBound() int {
+	pos := make(map[[2]uint]int, len(c.footprint))
+	for i, m := range c.footprint {
+		pos[m] = i
+	}
+
+	// lms[i][k] is the position of the leading monomial of the product of the
+	// i'th and the k'th standard monomial, or -1 if the product is zero
+	maxPos := 0
+	for _, m := range c.monomials {
+		if pos[m] > maxPos {
+			maxPos = pos[m]
+		}
+	}
+	lms := make([][]int, maxPos+1)
+	for i := range lms {
+		lms[i] = make([]int, len(c.footprint))
+		for k, n := range c.footprint {
+			prod := c.ring.PolynomialFromUnsigned(map[[2]uint]uint{
+				{c.footprint[i][0] + n[0], c.footprint[i][1] + n[1]}: 1,
+			})
+			if prod.IsZero() {
+				lms[i][k] = -1
+			} else {
+				lms[i][k] = pos[prod.Ld()]
+			}
+		}
+	}
+
+	bound := c.Length()
+	for _, m := range c.monomials {
+		i := pos[m]
+		found := make(map[int]struct{})
+	outer:
+		for k, lm := range lms[i] {
+			if lm < 0 {
+				continue
+			}
+			for j := 0; j < i; j++ {
+				if lms[j][k] >= lm {
+					continue outer
+				}
+			}
+			found[lm] = struct{}{}
+		}
+		if len(found) < bound {
+			bound = len(found)
+		}
+	}
+	return bound
+}
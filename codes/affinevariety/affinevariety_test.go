@@ -0,0 +1,202 @@
+package affinevariety
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ReneBoedker/algobra/bivariate"
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/internal/testutil"
+)
+
+var prg = rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
+
+// minDistance computes the minimum distance of c by encoding all messages.
+func minDistance(c *Code) int {
+	elems := c.field.Elements()
+	msg := make([]ff.Element, c.Dimension())
+	idx := make([]int, c.Dimension())
+	d := c.Length()
+	for {
+		// Advance to the next message
+		i := 0
+		for ; i < len(idx) && idx[i] == len(elems)-1; i++ {
+			idx[i] = 0
+		}
+		if i == len(idx) {
+			return d
+		}
+		idx[i]++
+		for j := range msg {
+			msg[j] = elems[idx[j]]
+		}
+
+		cw, _ := c.Encode(msg)
+		wt := 0
+		for _, e := range cw {
+			if e.IsNonzero() {
+				wt++
+			}
+		}
+		if wt < d {
+			d = wt
+		}
+	}
+}
+
+func TestHermitian(t *testing.T) {
+	for _, q := range []uint{2, 3} {
+		field := testutil.DefineField(q * q)
+		n := int(q * q * q)
+		g := int(q * (q - 1) / 2)
+		for s := 0; s < n+2*g; s++ {
+			c, err := NewHermitian(field, uint(s))
+			if err != nil {
+				t.Errorf("NewHermitian(GF(%d), %d) returned an error: %v", q*q, s, err)
+				continue
+			}
+			if c.Length() != n {
+				t.Errorf("%v has length %d, but expected %d", c, c.Length(), n)
+			}
+			if 2*g-2 < s && s < n && c.Dimension() != s+1-g {
+				t.Errorf("%v has dimension %d, but expected %d", c, c.Dimension(), s+1-g)
+			}
+			fr, fp := c.FengRaoBound(), c.FootprintBound()
+			if s < n && fr < n-s {
+				t.Errorf(
+					"%v has Feng–Rao bound %d, but the Goppa bound is %d",
+					c, fr, n-s,
+				)
+			}
+			if fr < fp {
+				t.Errorf(
+					"%v has Feng–Rao bound %d, but footprint bound %d",
+					c, fr, fp,
+				)
+			}
+			if c.Dimension() <= 4 {
+				if d := minDistance(c); fr > d {
+					t.Errorf(
+						"%v has Feng–Rao bound %d, but minimum distance %d",
+						c, fr, d,
+					)
+				}
+			}
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	for _, card := range []uint{4, 5, 7, 9} {
+		field := testutil.DefineField(card)
+		r := bivariate.DefRing(field, bivariate.DegRevLex(true))
+		// The points of the parabola Y=X^2
+		id, _ := r.NewIdeal(r.PolynomialFromSigned(map[[2]uint]int{
+			{2, 0}: 1,
+			{0, 1}: -1,
+		}))
+		monomials := [][2]uint{{0, 0}, {1, 0}, {0, 1}, {1, 1}}
+		c, err := New(id, monomials)
+		if err != nil {
+			t.Fatalf("New returned an error: %v", err)
+		}
+		if c.Length() != int(card) {
+			t.Errorf("%v has length %d, but expected %d", c, c.Length(), card)
+		}
+		for _, p := range c.Points() {
+			if !p[1].Equal(p[0].Times(p[0])) {
+				t.Errorf("Point %v of %v is not on the parabola", p, c)
+			}
+		}
+
+		for rep := 0; rep < 10; rep++ {
+			msg := testutil.RandomVector(field, c.Dimension())
+			cw, err := c.Encode(msg)
+			if err != nil {
+				t.Errorf("Encoding %v returned an error: %v", msg, err)
+				continue
+			}
+			f := r.Zero()
+			for i, m := range c.Monomials() {
+				f.SetCoef(m, msg[i])
+			}
+			for i, p := range c.Points() {
+				if !cw[i].Equal(f.Eval(p)) {
+					t.Errorf(
+						"Encoding %v gave %v, but %v evaluates to %v in %v",
+						msg, cw, f, f.Eval(p), p,
+					)
+				}
+			}
+		}
+	}
+}
+
+func TestReedMuller(t *testing.T) {
+	// Without other generators than a field equation, the codes are
+	// Reed–Muller codes
+	field := testutil.DefineField(5)
+	r := bivariate.DefRing(field, bivariate.DegLex(true))
+	id, _ := r.NewIdeal(r.PolynomialFromSigned(map[[2]uint]int{{5, 0}: 1, {1, 0}: -1}))
+	for deg := uint(0); deg < 4; deg++ {
+		monomials := make([][2]uint, 0)
+		for i := uint(0); i <= deg; i++ {
+			for j := uint(0); i+j <= deg; j++ {
+				monomials = append(monomials, [2]uint{i, j})
+			}
+		}
+		c, err := New(id, monomials)
+		if err != nil {
+			t.Fatalf("New returned an error: %v", err)
+		}
+		if c.Length() != 25 || c.Dimension() != len(monomials) {
+			t.Errorf("%v has unexpected parameters", c)
+		}
+		// The minimum distance of the Reed–Muller code is (q-deg)q
+		if b := c.FootprintBound(); b != int(5-deg)*5 {
+			t.Errorf("%v has footprint bound %d, but expected %d", c, b, (5-deg)*5)
+		}
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	field := testutil.DefineField(4)
+	r := bivariate.DefRing(field, bivariate.Lex(true))
+	id, _ := r.NewIdeal(r.PolynomialFromSigned(map[[2]uint]int{{2, 0}: 1, {0, 1}: 1}))
+
+	for _, monomials := range [][][2]uint{
+		{},
+		{{0, 0}, {2, 0}},
+		{{0, 0}, {0, 4}},
+		{{0, 1}, {0, 1}},
+	} {
+		if _, err := New(id, monomials); err == nil {
+			t.Errorf("New succeeded with monomials %v", monomials)
+		} else if !errors.Is(errors.InputValue, err) {
+			t.Errorf("New returned an error of unexpected kind (err = %v)", err)
+		}
+	}
+
+	qr, _ := r.Quotient(id)
+	qId, _ := qr.NewIdeal(qr.PolynomialFromSigned(map[[2]uint]int{{0, 1}: 1}))
+	if _, err := New(qId, [][2]uint{{0, 0}}); err == nil {
+		t.Errorf("New succeeded with an ideal of a quotient ring")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("New returned an error of unexpected kind (err = %v)", err)
+	}
+
+	if _, err := NewHermitian(testutil.DefineField(8), 3); err == nil {
+		t.Errorf("NewHermitian succeeded over GF(8)")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("NewHermitian returned an error of unexpected kind (err = %v)", err)
+	}
+
+	c, _ := NewHermitian(field, 3)
+	if _, err := c.Encode(testutil.RandomVector(field, c.Dimension()+1)); err == nil {
+		t.Errorf("Encode succeeded with a message of wrong length")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("Encode returned an error of unexpected kind (err = %v)", err)
+	}
+}
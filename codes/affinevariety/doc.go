@@ -0,0 +1,26 @@
+// Package affinevariety implements affine variety codes over finite fields.
+//
+// Let I be an ideal of the bivariate polynomial ring over the field with q
+// elements, and let J be the sum of I and the ideal generated by the field
+// equations X^q-X and Y^q-Y. The quotient ring modulo J is isomorphic to the
+// vector space of functions on the rational points of I, and a basis is given
+// by the standard monomials of J. An affine variety code is obtained by
+// evaluating the span of a subset of these monomials in the rational points.
+//
+//	gf9, _ := finitefield.Define(9)
+//	ring := bivariate.DefRing(gf9, bivariate.DegRevLex(true))
+//	parabola, _ := ring.PolynomialFromString("X^2 - Y")
+//	id, _ := ring.NewIdeal(parabola)
+//	code, err := affinevariety.New(id, [][2]uint{{0, 0}, {1, 0}, {0, 1}})
+//	if err != nil {
+//	    // New returns an error if the monomials are not distinct standard
+//	    // monomials
+//	}
+//
+// Hermitian codes are defined directly by NewHermitian.
+//
+// # Minimum distance
+//
+// The true minimum distance of an affine variety code is generally unknown.
+// Lower bounds are given by FootprintBound and by the stronger FengRaoBound.
+package affinevariety
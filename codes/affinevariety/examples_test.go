@@ -0,0 +1,54 @@
+package affinevariety_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ReneBoedker/algobra/bivariate"
+	"github.com/ReneBoedker/algobra/codes/affinevariety"
+	"github.com/ReneBoedker/algobra/finitefield"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+func ExampleNew() {
+	gf5, _ := finitefield.Define(5)
+	ring := bivariate.DefRing(gf5, bivariate.DegRevLex(true))
+
+	// The points of the parabola Y=X^2
+	parabola, _ := ring.PolynomialFromString("X^2 - Y")
+	id, _ := ring.NewIdeal(parabola)
+
+	code, err := affinevariety.New(id, [][2]uint{{0, 0}, {1, 0}, {0, 1}})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(code)
+
+	// Encode the polynomial 1 + 2X + Y
+	cw, _ := code.Encode([]ff.Element{
+		gf5.One(),
+		gf5.ElementFromUnsigned(2),
+		gf5.One(),
+	})
+	fmt.Println("Points:  ", code.Points())
+	fmt.Println("Codeword:", cw)
+	// Output:
+	// [5, 3] affine variety code over Finite field of 5 elements
+	// Points:   [[0 0] [1 1] [2 4] [3 4] [4 1]]
+	// Codeword: [1 4 4 1 0]
+}
+
+func ExampleNewHermitian() {
+	gf16, _ := finitefield.Define(16)
+	for _, s := range []uint{10, 20, 40} {
+		code, err := affinevariety.NewHermitian(gf16, s)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%v with minimum distance at least %d\n", code, code.FengRaoBound())
+	}
+	// Output:
+	// [64, 6] Hermitian code over Finite field of 16 elements with minimum distance at least 54
+	// [64, 15] Hermitian code over Finite field of 16 elements with minimum distance at least 44
+	// [64, 35] Hermitian code over Finite field of 16 elements with minimum distance at least 24
+}
@@ -0,0 +1,250 @@
+package bch
+
+import (
+	"fmt"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/finitefield/quotientfield"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+// Code is a narrow-sense BCH code of length n and designed distance d. Its
+// codewords are the coefficient vectors of the multiples of degree less than n
+// of the generator polynomial. The generator polynomial is the least common
+// multiple of the minimal polynomials of b, b^2, ..., b^(d-1), where b is a
+// primitive n'th root of unity in an extension field.
+type Code struct {
+	field     ff.Field
+	ring      *univariate.QuotientRing
+	ext       *quotientfield.Field
+	extRing   *univariate.QuotientRing
+	n         int
+	d         int
+	powers    []ff.Element // b^0, b^1, ..., b^(n-1)
+	generator *univariate.Polynomial
+}
+
+// New defines the narrow-sense BCH code of length n and designed distance d over
+// the given field.
+//
+// If n is not coprime to the cardinality of the field, or if d is not between
+// 2 and n, an InputValue-error is returned. If the extension field containing
+// the n'th roots of unity is too large, an InputTooLarge-error is returned.
+func New(field ff.Field, n, d int) (*Code, error) {
+	const op = "Defining BCH code"
+
+	if n < 2 || d < 2 || d > n {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Designed distance %d must be between 2 and the length %d", d, n,
+		)
+	}
+	ring := univariate.DefRing(field)
+	factors, err := ring.CyclotomicFactors(uint(n))
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	// The factors are the minimal polynomials of the powers of a primitive
+	// n'th root of unity b, and factors[1] is labelled by the coset of 1. In
+	// the extension field defined by this minimal polynomial, b is the class
+	// of X
+	ext, err := extensionField(factors[1].MinPoly)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	b := ext.ElementFromSlice([]ff.Element{field.Zero(), field.One()})
+	powers := make([]ff.Element, n)
+	powers[0] = ext.One()
+	for i := 1; i < n; i++ {
+		powers[i] = powers[i-1].Times(b)
+	}
+
+	// The generator is the least common multiple of the minimal polynomials of
+	// b, b^2, ..., b^(d-1). Distinct minimal polynomials are coprime, so this
+	// is the product of the factors whose cosets contain one of 1, 2, ..., d-1
+	generator := ring.One()
+	for _, f := range factors {
+		for _, i := range f.Coset {
+			if i >= 1 && i < uint(d) {
+				generator.Mult(f.MinPoly)
+				break
+			}
+		}
+	}
+
+	return &Code{
+		field:     field,
+		ring:      ring,
+		ext:       ext,
+		extRing:   univariate.DefRing(ext),
+		n:         n,
+		d:         d,
+		powers:    powers,
+		generator: generator,
+	}, nil
+}
+
+// extensionField returns the extension field obtained as the quotient modulo
+// the irreducible polynomial f.
+func extensionField(f *univariate.Polynomial) (*quotientfield.Field, error) {
+	const op = "Defining extension field"
+
+	ring := univariate.DefRing(f.BaseField())
+	id, err := ring.NewIdeal(ring.Polynomial(f.Coefs()))
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	qRing, err := ring.Quotient(id)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	ext, err := quotientfield.Define(qRing)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	return ext, nil
+}
+
+// toBaseField converts an element of the extension field to the base field.
+// The second return value is false if this is not possible.
+func (c *Code) toBaseField(a ff.Element) (ff.Element, bool) {
+	p := a.(*quotientfield.Element).AsPolynomial()
+	if p.Ld() > 0 {
+		return nil, false
+	}
+	return p.Coef(0), true
+}
+
+// toExtField converts an element of the base field to the extension field.
+func (c *Code) toExtField(a ff.Element) ff.Element {
+	return c.ext.ElementFromSlice([]ff.Element{a})
+}
+
+// String returns a string representation of c.
+func (c *Code) String() string {
+	return fmt.Sprintf(
+		"[%d, %d] BCH code with designed distance %d over %v",
+		c.Length(), c.Dimension(), c.d, c.field,
+	)
+}
+
+// Field returns the field over which c is defined.
+func (c *Code) Field() ff.Field {
+	return c.field
+}
+
+// Length returns the length of c.
+func (c *Code) Length() int {
+	return c.n
+}
+
+// Dimension returns the dimension of c. This is n minus the degree of the
+// generator polynomial.
+func (c *Code) Dimension() int {
+	return c.n - c.generator.Ld()
+}
+
+// DesignedDistance returns the designed distance of c. The minimum distance of
+// c is at least the designed distance.
+func (c *Code) DesignedDistance() int {
+	return c.d
+}
+
+// Generator returns a copy of the generator polynomial of c.
+func (c *Code) Generator() *univariate.Polynomial {
+	return c.generator.Copy()
+}
+
+// checkLength returns an InputValue-error if v does not have length n.
+func checkLength(op errors.Op, v []ff.Element, n int, desc string) error {
+	if len(v) != n {
+		return errors.New(
+			op, errors.InputValue,
+			"%s has length %d, but expected length %d", desc, len(v), n,
+		)
+	}
+	for _, e := range v {
+		if e.Err() != nil {
+			return errors.Wrap(op, errors.Inherit, e.Err())
+		}
+	}
+	return nil
+}
+
+// coefVector returns the coefficients of f of degree less than n.
+func coefVector(f *univariate.Polynomial, n int) []ff.Element {
+	out := make([]ff.Element, n)
+	for i := range out {
+		out[i] = f.Coef(i)
+	}
+	return out
+}
+
+// Encode returns the codeword corresponding to msg using non-systematic
+// encoding. That is, the codeword is the coefficient vector of m*g, where m is
+// the polynomial with coefficients msg and g is the generator polynomial.
+//
+// If msg does not have length k, an InputValue-error is returned.
+func (c *Code) Encode(msg []ff.Element) ([]ff.Element, error) {
+	const op = "Encoding message"
+
+	if err := checkLength(op, msg, c.Dimension(), "Message"); err != nil {
+		return nil, err
+	}
+	return coefVector(c.ring.Polynomial(msg).Times(c.generator), c.n), nil
+}
+
+// EncodeSystematic returns the codeword corresponding to msg using systematic
+// encoding. The message appears as the last k entries of the codeword, and the
+// first n-k entries are determined from the remainder modulo the generator
+// polynomial.
+//
+// If msg does not have length k, an InputValue-error is returned.
+func (c *Code) EncodeSystematic(msg []ff.Element) ([]ff.Element, error) {
+	const op = "Encoding message systematically"
+
+	if err := checkLength(op, msg, c.Dimension(), "Message"); err != nil {
+		return nil, err
+	}
+
+	shift := c.generator.Ld()
+	coefs := make([]ff.Element, c.n)
+	for i := 0; i < shift; i++ {
+		coefs[i] = c.field.Zero()
+	}
+	for i, m := range msg {
+		coefs[shift+i] = m.Copy()
+	}
+	f := c.ring.Polynomial(coefs)
+	_, r, err := f.QuoRem(c.generator)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	return coefVector(f.Minus(r), c.n), nil
+}
+
+// Message returns the message corresponding to the codeword under
+// non-systematic encoding. That is, the coefficients of the codeword
+// polynomial divided by the generator polynomial.
+//
+// If codeword is not a codeword of c, an InputValue-error is returned.
+func (c *Code) Message(codeword []ff.Element) ([]ff.Element, error) {
+	const op = "Computing message of codeword"
+
+	if err := checkLength(op, codeword, c.n, "Codeword"); err != nil {
+		return nil, err
+	}
+	q, r, err := c.ring.Polynomial(codeword).QuoRem(c.generator)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	if r.IsNonzero() {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Input is not a codeword",
+		)
+	}
+	return coefVector(q[0], c.Dimension()), nil
+}
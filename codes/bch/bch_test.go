@@ -0,0 +1,207 @@
+package bch
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/internal/testutil"
+)
+
+var prg = rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
+
+// decoders returns the decoding methods of code by name.
+func decoders(code *Code) map[string]func([]ff.Element) ([]ff.Element, error) {
+	return map[string]func([]ff.Element) ([]ff.Element, error){
+		"Decode":    code.Decode,
+		"DecodePGZ": code.DecodePGZ,
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	field := testutil.DefineField(4)
+
+	for _, s := range [][2]int{{15, 1}, {15, 16}, {1, 1}, {12, 3}} {
+		if _, err := New(field, s[0], s[1]); err == nil {
+			t.Errorf("New succeeded with length %d and designed distance %d", s[0], s[1])
+		} else if !errors.Is(errors.InputValue, err) {
+			t.Errorf("New returned an error of unexpected kind (err = %v)", err)
+		}
+	}
+}
+
+func TestDimension(t *testing.T) {
+	testCases := []struct {
+		card, n, d, k int
+	}{
+		{2, 15, 3, 11},
+		{2, 15, 5, 7},
+		{2, 15, 7, 5},
+		{2, 31, 7, 16},
+		{2, 7, 3, 4},
+		{3, 13, 4, 7},
+		{4, 15, 5, 9},
+		{7, 6, 4, 3}, // Reed–Solomon code
+	}
+
+	for _, tc := range testCases {
+		field := testutil.DefineField(uint(tc.card))
+		code, err := New(field, tc.n, tc.d)
+		if err != nil {
+			t.Fatalf("New failed for %v", tc)
+		}
+		if code.Dimension() != tc.k {
+			t.Errorf(
+				"BCH code with q = %d, n = %d, d = %d has dimension %d, but expected %d",
+				tc.card, tc.n, tc.d, code.Dimension(), tc.k,
+			)
+		}
+
+		// The generator must divide X^n - 1
+		xn := code.ring.Zero()
+		xn.SetCoef(tc.n, field.One())
+		xn.SetCoef(0, field.One().Neg())
+		if _, r, _ := xn.QuoRem(code.Generator()); r.IsNonzero() {
+			t.Errorf("Generator %v does not divide X^%d - 1", code.Generator(), tc.n)
+		}
+
+		// The generator must vanish at b, ..., b^(d-1)
+		extGen := code.extRing.Zero()
+		for i, c := range code.generator.Coefs() {
+			extGen.SetCoef(i, code.toExtField(c))
+		}
+		for i := 1; i < tc.d; i++ {
+			if extGen.Eval(code.powers[i]).IsNonzero() {
+				t.Errorf("Generator %v does not vanish at b^%d", code.Generator(), i)
+			}
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	field := testutil.DefineField(4)
+	code, err := New(field, 21, 7)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	for i := 0; i < 20; i++ {
+		msg := testutil.RandomVector(field, code.Dimension())
+
+		cw, err := code.Encode(msg)
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		if m, err := code.Message(cw); err != nil || !testutil.EqualVectors(m, msg) {
+			t.Errorf("Message(Encode(%v)) = %v (err = %v)", msg, m, err)
+		}
+
+		cw, err = code.EncodeSystematic(msg)
+		if err != nil {
+			t.Fatalf("EncodeSystematic failed: %v", err)
+		}
+		if !testutil.EqualVectors(cw[code.Length()-code.Dimension():], msg) {
+			t.Errorf("EncodeSystematic(%v) = %v is not systematic", msg, cw)
+		}
+		if synd, _ := code.Syndromes(cw); !allZero(synd) {
+			t.Errorf("Systematic encoding %v has non-zero syndromes %v", cw, synd)
+		}
+	}
+
+	if _, err := code.Encode(testutil.RandomVector(field, code.Dimension()+1)); err == nil {
+		t.Errorf("Encode succeeded with message of wrong length")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("Encode returned an error of unexpected kind (err = %v)", err)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	testCases := []struct {
+		card, n, d int
+	}{
+		{2, 15, 7},
+		{2, 31, 9},
+		{3, 26, 5},
+		{4, 15, 7},
+		{5, 24, 6},
+		{8, 63, 11},
+		{9, 20, 5},
+	}
+
+	for _, tc := range testCases {
+		field := testutil.DefineField(uint(tc.card))
+		code, err := New(field, tc.n, tc.d)
+		if err != nil {
+			t.Fatalf("New failed for %v: %v", tc, err)
+		}
+
+		for rep := 0; rep < 5; rep++ {
+			cw, _ := code.Encode(testutil.RandomVector(field, code.Dimension()))
+			received := make([]ff.Element, len(cw))
+			for i := range cw {
+				received[i] = cw[i].Copy()
+			}
+
+			nErrors := prg.Intn((tc.d-1)/2 + 1)
+			for _, p := range prg.Perm(tc.n)[:nErrors] {
+				e := field.RandElement()
+				for e.IsZero() {
+					e = field.RandElement()
+				}
+				received[p].Add(e)
+			}
+
+			for name, decode := range decoders(code) {
+				decoded, err := decode(received)
+				if err != nil {
+					t.Errorf(
+						"%s failed for %v with %d errors: %v",
+						name, code, nErrors, err,
+					)
+				} else if !testutil.EqualVectors(decoded, cw) {
+					t.Errorf(
+						"%s of %v gave %v, but expected %v",
+						name, received, decoded, cw,
+					)
+				}
+			}
+		}
+	}
+}
+
+func TestDecodeFailure(t *testing.T) {
+	field := testutil.DefineField(2)
+	code, _ := New(field, 15, 5)
+
+	// A weight 3 vector is at distance at least 2 from all codewords
+	received := testutil.RandomVector(field, 15)
+	for i := range received {
+		received[i].SetUnsigned(0)
+	}
+	failures := 0
+	for rep := 0; rep < 20; rep++ {
+		for _, p := range prg.Perm(15)[:3] {
+			received[p].SetUnsigned(1)
+		}
+		for name, decode := range decoders(code) {
+			decoded, err := decode(received)
+			if err != nil {
+				if !errors.Is(errors.InputValue, err) {
+					t.Errorf("%s returned an error of unexpected kind (err = %v)",
+						name, err)
+				}
+				failures++
+			} else if synd, _ := code.Syndromes(decoded); !allZero(synd) {
+				t.Errorf("%s returned %v, which is not a codeword", name, decoded)
+			}
+		}
+		for i := range received {
+			received[i].SetUnsigned(0)
+		}
+	}
+	if failures == 0 {
+		t.Logf("Decoding never failed for weight 3 errors")
+	}
+}
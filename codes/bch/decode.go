@@ -0,0 +1,283 @@
+package bch
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+// Syndromes returns the syndromes of the received word. That is, the
+// evaluations r(b^i) for i = 1, 2, ..., d-1, where r is the polynomial with
+// coefficients received. The syndromes belong to the extension field containing
+// the n'th roots of unity, and they are all zero if and only if received is a
+// codeword.
+//
+// If received does not have length n, an InputValue-error is returned.
+func (c *Code) Syndromes(received []ff.Element) ([]ff.Element, error) {
+	const op = "Computing syndromes"
+
+	if err := checkLength(op, received, c.n, "Received word"); err != nil {
+		return nil, err
+	}
+
+	extReceived := make([]ff.Element, c.n)
+	for j, r := range received {
+		extReceived[j] = c.toExtField(r)
+	}
+	return c.syndromes(extReceived), nil
+}
+
+// syndromes returns the syndromes of the received word, whose entries must be
+// elements of the extension field.
+func (c *Code) syndromes(received []ff.Element) []ff.Element {
+	out := make([]ff.Element, c.d-1)
+	tmp := c.ext.Zero()
+	for i := range out {
+		out[i] = c.ext.Zero()
+		for j, r := range received {
+			if r.IsZero() {
+				continue
+			}
+			out[i].Add(tmp.Prod(r, c.powers[((i+1)*j)%c.n]))
+		}
+	}
+	return out
+}
+
+// Decode corrects errors in the received word and returns the corresponding
+// codeword. Up to (d-1)/2 errors can be corrected, where d is the designed
+// distance.
+//
+// The decoding computes the syndromes of the received word, from which the
+// error locator polynomial is found using the Berlekamp–Massey algorithm. The
+// error positions are the roots of the locator, which are found by a Chien
+// search, and the error values are computed using Forney's formula.
+//
+// An InputValue-error is returned if received has the wrong length or if the
+// decoding fails due to too many errors.
+func (c *Code) Decode(received []ff.Element) ([]ff.Element, error) {
+	const op = "Decoding received word"
+	return c.decode(op, received, c.berlekampMasseyLocator)
+}
+
+// DecodePGZ corrects errors in the received word and returns the corresponding
+// codeword. Up to (d-1)/2 errors can be corrected, where d is the designed
+// distance.
+//
+// The decoding uses the Peterson–Gorenstein–Zierler algorithm. That is, the
+// error locator polynomial is found by solving the linear system given by the
+// syndromes, where the number of errors is the size of the largest non-singular
+// syndrome matrix. The error positions and values are then found as in Decode.
+// Since the linear systems are solved by Gaussian elimination, Decode is faster
+// when many errors can be corrected.
+//
+// An InputValue-error is returned if received has the wrong length or if the
+// decoding fails due to too many errors.
+func (c *Code) DecodePGZ(received []ff.Element) ([]ff.Element, error) {
+	const op = "Decoding received word using Peterson–Gorenstein–Zierler"
+	return c.decode(op, received, c.pgzLocator)
+}
+
+// decode corrects errors in the received word, using locate to compute the
+// error locator polynomial and the number of errors from the syndromes.
+func (c *Code) decode(
+	op errors.Op,
+	received []ff.Element,
+	locate func(synd []ff.Element) (*univariate.Polynomial, int),
+) ([]ff.Element, error) {
+	if err := checkLength(op, received, c.n, "Received word"); err != nil {
+		return nil, err
+	}
+
+	failure := errors.New(
+		op, errors.InputValue,
+		"Too many errors to decode",
+	)
+
+	extReceived := make([]ff.Element, c.n)
+	for j, r := range received {
+		extReceived[j] = c.toExtField(r)
+	}
+	synd := c.syndromes(extReceived)
+
+	out := make([]ff.Element, c.n)
+	for i, r := range received {
+		out[i] = r.Copy()
+	}
+	if allZero(synd) {
+		return out, nil
+	}
+
+	locator, nErrors := locate(synd)
+	if err := locator.Err(); err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	if 2*nErrors > c.d-1 || locator.Ld() != nErrors {
+		return nil, failure
+	}
+
+	positions := c.chienSearch(locator)
+	if len(positions) != nErrors {
+		return nil, failure
+	}
+
+	values, ok := c.forney(synd, locator, positions)
+	if !ok {
+		return nil, failure
+	}
+
+	for i, p := range positions {
+		out[p].Sub(values[i])
+	}
+
+	// Make sure that the result is a codeword
+	for j, r := range out {
+		extReceived[j] = c.toExtField(r)
+	}
+	if !allZero(c.syndromes(extReceived)) {
+		return nil, failure
+	}
+	return out, nil
+}
+
+// berlekampMasseyLocator returns the error locator polynomial and the number
+// of errors. The connection polynomial of the syndrome sequence is the error
+// locator polynomial prod(1-X_i*X), where X_i = b^(p_i) for each error position
+// p_i.
+func (c *Code) berlekampMasseyLocator(synd []ff.Element) (*univariate.Polynomial, int) {
+	return c.extRing.BerlekampMassey(synd)
+}
+
+// pgzLocator returns the error locator polynomial and the number of errors.
+//
+// If v errors occurred, the coefficients L_1, ..., L_v of the locator satisfy
+// S_(i+v) + L_1*S_(i+v-1) + ... + L_v*S_i = 0 for i = 1, ..., v. The v by v
+// syndrome matrix of this system is non-singular, while the larger ones are
+// singular. If no syndrome matrix is non-singular, the constant polynomial one
+// is returned.
+func (c *Code) pgzLocator(synd []ff.Element) (*univariate.Polynomial, int) {
+	for v := (c.d - 1) / 2; v > 0; v-- {
+		// Row i contains S_(i+1), ..., S_(i+v) followed by -S_(i+v+1). The
+		// j'th unknown is the coefficient L_(v-j)
+		system := make([][]ff.Element, v)
+		for i := range system {
+			system[i] = make([]ff.Element, v+1)
+			for j := 0; j < v; j++ {
+				system[i][j] = synd[i+j].Copy()
+			}
+			system[i][v] = synd[i+v].Neg()
+		}
+		if !solve(system) {
+			continue
+		}
+
+		coefs := make([]ff.Element, v+1)
+		coefs[0] = c.ext.One()
+		for l := 1; l <= v; l++ {
+			coefs[l] = system[v-l][v]
+		}
+		return c.extRing.Polynomial(coefs), v
+	}
+	return c.extRing.One(), 0
+}
+
+// solve transforms the augmented matrix of a square linear system in-place.
+// If the system is non-singular, the function returns true, and the solution
+// is found in the last column. Otherwise, it returns false.
+func solve(system [][]ff.Element) bool {
+	tmp := system[0][0].Copy()
+	for col := range system {
+		// Find a row with non-zero entry in this column
+		pivot := -1
+		for i := col; i < len(system); i++ {
+			if system[i][col].IsNonzero() {
+				pivot = i
+				break
+			}
+		}
+		if pivot == -1 {
+			return false
+		}
+		system[col], system[pivot] = system[pivot], system[col]
+
+		if lc := system[col][col]; !lc.IsOne() {
+			lcInv := lc.Inv()
+			for j := col; j < len(system[col]); j++ {
+				system[col][j].Mult(lcInv)
+			}
+		}
+
+		for i := range system {
+			if i == col || system[i][col].IsZero() {
+				continue
+			}
+			c := system[i][col].Copy()
+			for j := col; j < len(system[i]); j++ {
+				system[i][j].Sub(tmp.Prod(c, system[col][j]))
+			}
+		}
+	}
+	return true
+}
+
+// allZero reports whether all elements of v are zero.
+func allZero(v []ff.Element) bool {
+	for _, e := range v {
+		if e.IsNonzero() {
+			return false
+		}
+	}
+	return true
+}
+
+// chienSearch returns the positions p for which b^(-p) is a root of the
+// locator polynomial.
+func (c *Code) chienSearch(locator *univariate.Polynomial) []int {
+	coefs := locator.Coefs()
+	positions := make([]int, 0, len(coefs)-1)
+	sum, tmp := c.ext.Zero(), c.ext.Zero()
+	for p := 0; p < c.n; p++ {
+		// Evaluate the locator at b^(-p) using the precomputed powers
+		sum.SetUnsigned(0)
+		for l, coef := range coefs {
+			if coef.IsZero() {
+				continue
+			}
+			sum.Add(tmp.Prod(coef, c.powers[(c.n-(p*l)%c.n)%c.n]))
+		}
+		if sum.IsZero() {
+			positions = append(positions, p)
+		}
+	}
+	return positions
+}
+
+// forney computes the error values in the given positions using Forney's
+// formula. The second return value is false if an error value does not belong
+// to the base field.
+func (c *Code) forney(synd []ff.Element, locator *univariate.Polynomial, positions []int) ([]ff.Element, bool) {
+	// Compute the error evaluator polynomial S*locator mod X^(d-1)
+	evaluator := c.extRing.Polynomial(synd).Times(locator)
+	coefs := make([]ff.Element, c.d-1)
+	for i := range coefs {
+		coefs[i] = evaluator.Coef(i)
+	}
+	evaluator = c.extRing.Polynomial(coefs)
+	deriv := locator.Derivative()
+
+	values := make([]ff.Element, len(positions))
+	for i, p := range positions {
+		xInv := c.powers[(c.n-p)%c.n]
+		den := deriv.Eval(xInv)
+		if den.IsZero() {
+			return nil, false
+		}
+		e := evaluator.Eval(xInv).Mult(den.Inv()).SetNeg()
+
+		var ok bool
+		if values[i], ok = c.toBaseField(e); !ok {
+			return nil, false
+		}
+	}
+	return values, true
+}
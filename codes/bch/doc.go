@@ -0,0 +1,34 @@
+// Package bch implements narrow-sense BCH codes over finite fields.
+//
+// A BCH code of length n and designed distance d is a cyclic code whose
+// generator polynomial is the least common multiple of the minimal polynomials
+// of b, b^2, ..., b^(d-1), where b is a primitive n'th root of unity in an
+// extension field. The length must be coprime to the cardinality of the field,
+// and the minimum distance of the code is at least d.
+//
+//	gf2, _ := finitefield.Define(2)
+//	code, err := bch.New(gf2, 15, 5)
+//	if err != nil {
+//	    // New returns an error if the length is not coprime to the field
+//	    // cardinality or if the designed distance is invalid
+//	}
+//
+// # Encoding
+//
+// Messages can be encoded either non-systematically using Encode or
+// systematically using EncodeSystematic. In the first case, the message is the
+// coefficient vector of the polynomial that is multiplied by the generator
+// polynomial. In the second case, the message appears as the last k entries of
+// the codeword.
+//
+// # Decoding
+//
+// The method Decode corrects up to (d-1)/2 errors. It computes the syndromes of
+// the received word and finds the error locator polynomial using the
+// Berlekamp–Massey algorithm. The error positions are found by a Chien search,
+// and the error values are computed using Forney's formula.
+//
+// Alternatively, DecodePGZ finds the error locator polynomial using the
+// Peterson–Gorenstein–Zierler algorithm, which solves the linear system given by
+// the syndromes. This is simpler, but slower when many errors can be corrected.
+package bch
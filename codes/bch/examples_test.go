@@ -0,0 +1,46 @@
+package bch_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ReneBoedker/algobra/codes/bch"
+	"github.com/ReneBoedker/algobra/finitefield"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+func ExampleCode_Decode() {
+	gf2, _ := finitefield.Define(2)
+	code, err := bch.New(gf2, 15, 5)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(code)
+
+	msg := make([]ff.Element, code.Dimension())
+	for i := range msg {
+		msg[i] = gf2.ElementFromUnsigned(uint(i % 2))
+	}
+	cw, _ := code.EncodeSystematic(msg)
+
+	// Introduce errors in two positions
+	received := make([]ff.Element, len(cw))
+	for i := range cw {
+		received[i] = cw[i].Copy()
+	}
+	received[3].Add(gf2.One())
+	received[11].Add(gf2.One())
+
+	corrected, err := code.Decode(received)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Codeword: ", cw)
+	fmt.Println("Received: ", received)
+	fmt.Println("Corrected:", corrected)
+	// Output:
+	// [15, 7] BCH code with designed distance 5 over Finite field of 2 elements
+	// Codeword:  [0 1 0 1 1 0 0 0 0 1 0 1 0 1 0]
+	// Received:  [0 1 0 0 1 0 0 0 0 1 0 0 0 1 0]
+	// Corrected: [0 1 0 1 1 0 0 0 0 1 0 1 0 1 0]
+}
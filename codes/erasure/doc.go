@@ -0,0 +1,29 @@
+// Package erasure implements byte-oriented Reed–Solomon erasure codes over the
+// field of 256 elements.
+//
+// Data is split into k data shards, from which m parity shards are computed.
+// The original data can be recovered from any k of the k+m shards. Each byte is
+// identified with the element of binfield.Field whose bit representation it is,
+// and the arithmetic is done using precomputed lookup tables.
+//
+//	code, err := erasure.New(10, 4)
+//	if err != nil {
+//	    // New returns an error if the number of shards is invalid
+//	}
+//
+//	shards, _ := code.Split(data)
+//	_ = code.Encode(shards)
+//
+// # Reconstruction
+//
+// Missing shards are represented by slices of length zero. As long as at least
+// k shards remain, Reconstruct recreates the missing ones. Corrupted shards
+// cannot be located by Reconstruct, but Verify reports whether the shards are
+// consistent.
+//
+// # Streaming
+//
+// For data that does not fit in memory, the methods StreamSplit, StreamEncode,
+// StreamVerify, StreamReconstruct, and StreamJoin work on io.Reader and
+// io.Writer instead of byte slices.
+package erasure
@@ -0,0 +1,388 @@
+package erasure
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// Code is the implementation of a systematic Reed–Solomon erasure code over the
+// field of 256 elements.
+type Code struct {
+	dataShards   int
+	parityShards int
+	parity       matrix
+}
+
+// New returns a code with the given number of data and parity shards. Any
+// dataShards of the shards suffice to reconstruct all of them.
+//
+// The parity shards are computed using a Cauchy matrix, which guarantees that
+// the code is maximum distance separable.
+//
+// If the number of data or parity shards is not positive, or if the total
+// number of shards exceeds 256, an InputValue-error is returned.
+func New(dataShards, parityShards int) (*Code, error) {
+	const op = "Defining erasure code"
+
+	if dataShards <= 0 || parityShards <= 0 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Number of data and parity shards must be positive",
+		)
+	}
+	if dataShards+parityShards > 256 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Total number of shards (%d) exceeds 256", dataShards+parityShards,
+		)
+	}
+
+	return &Code{
+		dataShards:   dataShards,
+		parityShards: parityShards,
+		parity:       cauchyMatrix(parityShards, dataShards),
+	}, nil
+}
+
+// String returns the string representation of c.
+func (c *Code) String() string {
+	return fmt.Sprintf(
+		"Erasure code with %d data and %d parity shards",
+		c.dataShards, c.parityShards,
+	)
+}
+
+// Field returns the field of 256 elements over which c is defined. A byte b
+// corresponds to the element with bit representation b.
+func (c *Code) Field() ff.Field {
+	return gf256
+}
+
+// DataShards returns the number of data shards of c.
+func (c *Code) DataShards() int {
+	return c.dataShards
+}
+
+// ParityShards returns the number of parity shards of c.
+func (c *Code) ParityShards() int {
+	return c.parityShards
+}
+
+// Shards returns the total number of shards of c.
+func (c *Code) Shards() int {
+	return c.dataShards + c.parityShards
+}
+
+// generatorRows returns the rows of the systematic generator matrix with the
+// given indices. That is, row i is the i'th unit vector if i is less than the
+// number of data shards, and otherwise it is a row of the parity matrix.
+func (c *Code) generatorRows(indices []int) matrix {
+	out := newMatrix(len(indices), c.dataShards)
+	for i, j := range indices {
+		if j < c.dataShards {
+			out[i][j] = 1
+		} else {
+			copy(out[i], c.parity[j-c.dataShards])
+		}
+	}
+	return out
+}
+
+// checkShards checks that shards has the correct length and that all shards
+// accepted by the present-function have the same size. The function returns
+// this size.
+//
+// If the number of shards is wrong, an InputValue-error is returned. If the
+// shards have different sizes, an InputIncompatible-error is returned.
+func (c *Code) checkShards(op errors.Op, shards [][]byte, present func(i int) bool) (int, error) {
+	if len(shards) != c.Shards() {
+		return 0, errors.New(
+			op, errors.InputValue,
+			"Expected %d shards, but received %d", c.Shards(), len(shards),
+		)
+	}
+
+	size := -1
+	for i, s := range shards {
+		if !present(i) {
+			continue
+		}
+		if size == -1 {
+			size = len(s)
+		} else if len(s) != size {
+			return 0, errors.New(
+				op, errors.InputIncompatible,
+				"Shard %d has size %d, but previous shards have size %d",
+				i, len(s), size,
+			)
+		}
+	}
+	return size, nil
+}
+
+// resize returns a slice of length size. If the capacity of s is sufficient,
+// s is resliced. Otherwise, a new slice is allocated.
+func resize(s []byte, size int) []byte {
+	if cap(s) >= size {
+		return s[:size]
+	}
+	return make([]byte, size)
+}
+
+// Encode computes the parity shards from the data shards. The first
+// DataShards() entries of shards are the data shards, and the remaining ones
+// are overwritten with the parity shards. Parity shards that are nil or too
+// small are allocated.
+//
+// If the number of shards is wrong, an InputValue-error is returned. If the
+// data shards have different sizes, an InputIncompatible-error is returned.
+func (c *Code) Encode(shards [][]byte) error {
+	const op = "Encoding shards"
+
+	size, err := c.checkShards(op, shards, func(i int) bool {
+		return i < c.dataShards
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := c.dataShards; i < len(shards); i++ {
+		shards[i] = resize(shards[i], size)
+	}
+	c.parity.apply(shards[:c.dataShards], shards[c.dataShards:])
+	return nil
+}
+
+// Verify reports whether the parity shards are consistent with the data
+// shards.
+//
+// If the number of shards is wrong, an InputValue-error is returned. If the
+// shards have different sizes, an InputIncompatible-error is returned.
+func (c *Code) Verify(shards [][]byte) (bool, error) {
+	const op = "Verifying shards"
+
+	size, err := c.checkShards(op, shards, func(int) bool { return true })
+	if err != nil {
+		return false, err
+	}
+
+	computed := newMatrix(c.parityShards, size)
+	c.parity.apply(shards[:c.dataShards], computed)
+	for i, s := range computed {
+		if !bytes.Equal(s, shards[c.dataShards+i]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Reconstruct recreates the missing shards. A shard is considered missing if
+// it has length zero. At least DataShards() shards must be present. Missing
+// shards are resliced if their capacity is sufficient, and otherwise they are
+// allocated.
+//
+// Note that Reconstruct cannot detect corrupted shards. Use Verify for this
+// purpose.
+//
+// If the number of shards is wrong or if too few shards are present, an
+// InputValue-error is returned. If the present shards have different sizes, an
+// InputIncompatible-error is returned.
+func (c *Code) Reconstruct(shards [][]byte) error {
+	const op = "Reconstructing shards"
+
+	size, err := c.checkShards(op, shards, func(i int) bool {
+		return len(shards[i]) > 0
+	})
+	if err != nil {
+		return err
+	}
+
+	rec, err := c.planReconstruction(op, func(i int) bool {
+		return len(shards[i]) > 0
+	})
+	if err != nil {
+		return err
+	}
+	rec.apply(shards, size)
+	return nil
+}
+
+// reconstruction describes how the missing shards are computed from a fixed
+// set of present shards. The decoding matrix is inverted once, so the
+// reconstruction can be applied to many blocks of shards.
+type reconstruction struct {
+	present       []int
+	missingData   []int
+	dataRows      matrix
+	missingParity []int
+	parityRows    matrix
+}
+
+// planReconstruction prepares the reconstruction of the shards that are not
+// accepted by the present-function.
+//
+// If too few shards are present, an InputValue-error is returned.
+func (c *Code) planReconstruction(op errors.Op, present func(i int) bool) (*reconstruction, error) {
+	rec := &reconstruction{
+		present:       make([]int, 0, c.dataShards),
+		missingData:   make([]int, 0),
+		missingParity: make([]int, 0),
+	}
+	for i := 0; i < c.Shards(); i++ {
+		switch {
+		case present(i):
+			if len(rec.present) < c.dataShards {
+				rec.present = append(rec.present, i)
+			}
+		case i < c.dataShards:
+			rec.missingData = append(rec.missingData, i)
+		default:
+			rec.missingParity = append(rec.missingParity, i)
+		}
+	}
+	if len(rec.present) < c.dataShards {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"At least %d shards are needed, but only %d are present",
+			c.dataShards, len(rec.present),
+		)
+	}
+
+	if len(rec.missingData) > 0 {
+		// Express the data shards in terms of the first present shards
+		decode, err := c.generatorRows(rec.present).invert()
+		if err != nil {
+			// Every square submatrix of the generator matrix is invertible
+			return nil, errors.Wrap(op, errors.Internal, err)
+		}
+		rec.dataRows = make(matrix, len(rec.missingData))
+		for i, j := range rec.missingData {
+			rec.dataRows[i] = decode[j]
+		}
+	}
+
+	rec.parityRows = make(matrix, len(rec.missingParity))
+	for i, j := range rec.missingParity {
+		rec.parityRows[i] = c.parity[j-c.dataShards]
+	}
+	return rec, nil
+}
+
+// apply recreates the missing shards, all of which get the given size. The
+// missing data shards are computed first, since the missing parity shards are
+// computed from the data shards.
+func (rec *reconstruction) apply(shards [][]byte, size int) {
+	if len(rec.missingData) > 0 {
+		inputs := make([][]byte, len(rec.present))
+		for i, j := range rec.present {
+			inputs[i] = shards[j]
+		}
+		outputs := make([][]byte, len(rec.missingData))
+		for i, j := range rec.missingData {
+			shards[j] = resize(shards[j], size)
+			outputs[i] = shards[j]
+		}
+		rec.dataRows.apply(inputs, outputs)
+	}
+
+	if len(rec.missingParity) > 0 {
+		outputs := make([][]byte, len(rec.missingParity))
+		for i, j := range rec.missingParity {
+			shards[j] = resize(shards[j], size)
+			outputs[i] = shards[j]
+		}
+		// The number of present shards equals the number of data shards
+		rec.parityRows.apply(shards[:len(rec.present)], outputs)
+	}
+}
+
+// Split divides data into DataShards() data shards of equal size and allocates
+// the parity shards. If necessary, the last data shard is padded with zeros.
+// The parity shards must be computed using Encode.
+//
+// The data shards share memory with data whenever possible.
+//
+// If data is empty, an InputValue-error is returned.
+func (c *Code) Split(data []byte) ([][]byte, error) {
+	const op = "Splitting data"
+
+	if len(data) == 0 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Cannot split empty data",
+		)
+	}
+
+	size := (len(data) + c.dataShards - 1) / c.dataShards
+	if cap(data) < c.dataShards*size {
+		padded := make([]byte, c.dataShards*size)
+		copy(padded, data)
+		data = padded
+	} else {
+		data = data[:c.dataShards*size]
+		for i := len(data); i < c.dataShards*size; i++ {
+			data[i] = 0
+		}
+	}
+
+	shards := make([][]byte, c.Shards())
+	for i := 0; i < c.dataShards; i++ {
+		shards[i] = data[i*size : (i+1)*size : (i+1)*size]
+	}
+	for i := c.dataShards; i < len(shards); i++ {
+		shards[i] = make([]byte, size)
+	}
+	return shards, nil
+}
+
+// Join writes the first size bytes of the data shards to dst. This reverses
+// Split when size is the length of the original data.
+//
+// If the number of shards is wrong, if a data shard is missing, or if the data
+// shards contain fewer than size bytes, an InputValue-error is returned. If
+// writing to dst fails, the error is wrapped in an Input-error.
+func (c *Code) Join(dst io.Writer, shards [][]byte, size int) error {
+	const op = "Joining shards"
+
+	if len(shards) != c.Shards() {
+		return errors.New(
+			op, errors.InputValue,
+			"Expected %d shards, but received %d", c.Shards(), len(shards),
+		)
+	}
+
+	total := 0
+	for i, s := range shards[:c.dataShards] {
+		if len(s) == 0 {
+			return errors.New(
+				op, errors.InputValue,
+				"Data shard %d is missing", i,
+			)
+		}
+		total += len(s)
+	}
+	if total < size {
+		return errors.New(
+			op, errors.InputValue,
+			"Data shards contain %d bytes, but %d were requested", total, size,
+		)
+	}
+
+	for _, s := range shards[:c.dataShards] {
+		if len(s) > size {
+			s = s[:size]
+		}
+		if _, err := dst.Write(s); err != nil {
+			return errors.Wrap(op, errors.Input, err)
+		}
+		size -= len(s)
+		if size == 0 {
+			break
+		}
+	}
+	return nil
+}
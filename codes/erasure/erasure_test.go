@@ -0,0 +1,244 @@
+package erasure
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/binfield"
+)
+
+var prg = rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
+
+func randomBytes(n int) []byte {
+	out := make([]byte, n)
+	prg.Read(out)
+	return out
+}
+
+func copyShards(shards [][]byte) [][]byte {
+	out := make([][]byte, len(shards))
+	for i, s := range shards {
+		out[i] = append([]byte{}, s...)
+	}
+	return out
+}
+
+func TestTables(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		a, b := byte(prg.Intn(256)), byte(prg.Intn(256))
+		expected := gf256.ElementFromBits(uint(a)).Times(
+			gf256.ElementFromBits(uint(b)),
+		).(*binfield.Element).AsBits()
+		if uint(mul(a, b)) != expected {
+			t.Errorf("%d*%d = %d, but expected %d", a, b, mul(a, b), expected)
+		}
+		if a != 0 && mul(a, inv(a)) != 1 {
+			t.Errorf("%d*%d = %d, but expected 1", a, inv(a), mul(a, inv(a)))
+		}
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	for _, s := range [][2]int{{0, 2}, {3, 0}, {-1, 2}, {200, 57}} {
+		if _, err := New(s[0], s[1]); err == nil {
+			t.Errorf("New succeeded with %d data and %d parity shards", s[0], s[1])
+		} else if !errors.Is(errors.InputValue, err) {
+			t.Errorf("New returned an error of unexpected kind (err = %v)", err)
+		}
+	}
+}
+
+func TestReconstruct(t *testing.T) {
+	for _, s := range [][2]int{{1, 1}, {4, 2}, {10, 4}, {17, 3}, {200, 56}} {
+		code, err := New(s[0], s[1])
+		if err != nil {
+			t.Fatalf("New failed with %d data and %d parity shards", s[0], s[1])
+		}
+
+		data := randomBytes(prg.Intn(5000) + 1)
+		shards, err := code.Split(append([]byte{}, data...))
+		if err != nil {
+			t.Fatalf("Split failed: %v", err)
+		}
+		if err := code.Encode(shards); err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		if ok, err := code.Verify(shards); !ok || err != nil {
+			t.Errorf("Verify failed for encoded shards (err = %v)", err)
+		}
+		original := copyShards(shards)
+
+		// Remove the maximal number of shards
+		for _, i := range prg.Perm(code.Shards())[:s[1]] {
+			shards[i] = nil
+		}
+		if err := code.Reconstruct(shards); err != nil {
+			t.Fatalf("Reconstruct failed: %v", err)
+		}
+		for i := range shards {
+			if !bytes.Equal(shards[i], original[i]) {
+				t.Errorf("Shard %d was reconstructed incorrectly", i)
+			}
+		}
+
+		var buf bytes.Buffer
+		if err := code.Join(&buf, shards, len(data)); err != nil {
+			t.Fatalf("Join failed: %v", err)
+		}
+		if !bytes.Equal(buf.Bytes(), data) {
+			t.Errorf("Joined data differs from the original data")
+		}
+
+		// Corrupt a shard
+		shards[prg.Intn(code.Shards())][0] ^= 1
+		if ok, err := code.Verify(shards); ok || err != nil {
+			t.Errorf("Verify accepted corrupted shards (err = %v)", err)
+		}
+
+		// Remove too many shards
+		for _, i := range prg.Perm(code.Shards())[:s[1]+1] {
+			shards[i] = nil
+		}
+		if err := code.Reconstruct(shards); err == nil {
+			t.Errorf("Reconstruct succeeded with too few shards")
+		} else if !errors.Is(errors.InputValue, err) {
+			t.Errorf("Reconstruct returned an error of unexpected kind (err = %v)", err)
+		}
+	}
+}
+
+func TestShardErrors(t *testing.T) {
+	code, _ := New(3, 2)
+
+	if err := code.Encode(make([][]byte, 4)); !errors.Is(errors.InputValue, err) {
+		t.Errorf("Encode returned unexpected error for wrong number of shards (err = %v)", err)
+	}
+
+	shards := [][]byte{randomBytes(5), randomBytes(5), randomBytes(4), nil, nil}
+	if err := code.Encode(shards); !errors.Is(errors.InputIncompatible, err) {
+		t.Errorf("Encode returned unexpected error for unequal shard sizes (err = %v)", err)
+	}
+
+	if _, err := code.Split([]byte{}); !errors.Is(errors.InputValue, err) {
+		t.Errorf("Split returned unexpected error for empty data (err = %v)", err)
+	}
+}
+
+func TestStream(t *testing.T) {
+	code, _ := New(5, 3)
+
+	size := int64(3*streamBlockSize + 17)
+	data := randomBytes(int(size))
+
+	// Split and encode
+	dataBufs := make([]*bytes.Buffer, code.DataShards())
+	writers := make([]io.Writer, code.DataShards())
+	for i := range dataBufs {
+		dataBufs[i] = &bytes.Buffer{}
+		writers[i] = dataBufs[i]
+	}
+	if err := code.StreamSplit(bytes.NewReader(data), writers, size); err != nil {
+		t.Fatalf("StreamSplit failed: %v", err)
+	}
+
+	parityBufs := make([]*bytes.Buffer, code.ParityShards())
+	readers := make([]io.Reader, code.DataShards())
+	writers = make([]io.Writer, code.ParityShards())
+	for i := range dataBufs {
+		readers[i] = bytes.NewReader(dataBufs[i].Bytes())
+	}
+	for i := range parityBufs {
+		parityBufs[i] = &bytes.Buffer{}
+		writers[i] = parityBufs[i]
+	}
+	if err := code.StreamEncode(readers, writers); err != nil {
+		t.Fatalf("StreamEncode failed: %v", err)
+	}
+
+	// Compare with the in-memory implementation
+	shards, _ := code.Split(append([]byte{}, data...))
+	code.Encode(shards)
+	all := append(dataBufs, parityBufs...)
+	for i := range shards {
+		if !bytes.Equal(shards[i], all[i].Bytes()) {
+			t.Errorf("Streamed shard %d differs from in-memory shard", i)
+		}
+	}
+
+	readers = make([]io.Reader, code.Shards())
+	for i := range readers {
+		readers[i] = bytes.NewReader(shards[i])
+	}
+	if ok, err := code.StreamVerify(readers); !ok || err != nil {
+		t.Errorf("StreamVerify failed for encoded shards (err = %v)", err)
+	}
+
+	// Reconstruct missing shards
+	missing := prg.Perm(code.Shards())[:code.ParityShards()]
+	fills := make([]*bytes.Buffer, code.Shards())
+	writers = make([]io.Writer, code.Shards())
+	for i := range readers {
+		readers[i] = bytes.NewReader(shards[i])
+	}
+	for _, i := range missing {
+		readers[i] = nil
+		fills[i] = &bytes.Buffer{}
+		writers[i] = fills[i]
+	}
+	if err := code.StreamReconstruct(readers, writers); err != nil {
+		t.Fatalf("StreamReconstruct failed: %v", err)
+	}
+	for _, i := range missing {
+		if !bytes.Equal(fills[i].Bytes(), shards[i]) {
+			t.Errorf("Shard %d was reconstructed incorrectly", i)
+		}
+	}
+
+	// Join
+	readers = make([]io.Reader, code.DataShards())
+	for i := range readers {
+		readers[i] = bytes.NewReader(shards[i])
+	}
+	var out bytes.Buffer
+	if err := code.StreamJoin(&out, readers, size); err != nil {
+		t.Fatalf("StreamJoin failed: %v", err)
+	}
+	if !bytes.Equal(out.Bytes(), data) {
+		t.Errorf("Joined data differs from the original data")
+	}
+}
+
+func TestStreamErrors(t *testing.T) {
+	code, _ := New(2, 1)
+
+	readers := []io.Reader{bytes.NewReader(randomBytes(10)), bytes.NewReader(randomBytes(9))}
+	writers := []io.Writer{&bytes.Buffer{}}
+	if err := code.StreamEncode(readers, writers); !errors.Is(errors.InputIncompatible, err) {
+		t.Errorf("StreamEncode returned unexpected error for unequal shard sizes (err = %v)", err)
+	}
+
+	writers = []io.Writer{&bytes.Buffer{}, &bytes.Buffer{}}
+	if err := code.StreamSplit(bytes.NewReader(randomBytes(10)), writers, 11); !errors.Is(errors.InputValue, err) {
+		t.Errorf("StreamSplit returned unexpected error for short data (err = %v)", err)
+	}
+
+	readers = []io.Reader{bytes.NewReader(randomBytes(3)), nil, nil}
+	writers = []io.Writer{nil, &bytes.Buffer{}, &bytes.Buffer{}}
+	if err := code.StreamReconstruct(readers, writers); !errors.Is(errors.InputValue, err) {
+		t.Errorf("StreamReconstruct returned unexpected error for too few shards (err = %v)", err)
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	code, _ := New(10, 4)
+	shards, _ := code.Split(randomBytes(1 << 20))
+	b.SetBytes(1 << 20)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		code.Encode(shards)
+	}
+}
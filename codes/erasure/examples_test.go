@@ -0,0 +1,36 @@
+package erasure_test
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/ReneBoedker/algobra/codes/erasure"
+)
+
+func ExampleCode_Reconstruct() {
+	code, err := erasure.New(4, 2)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(code)
+
+	data := []byte("Any four of the six shards will do!")
+	shards, _ := code.Split(data)
+	if err := code.Encode(shards); err != nil {
+		log.Fatal(err)
+	}
+
+	// Lose a data shard and a parity shard
+	shards[1], shards[5] = nil, nil
+	if err := code.Reconstruct(shards); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := code.Join(os.Stdout, shards, len(data)); err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// Erasure code with 4 data and 2 parity shards
+	// Any four of the six shards will do!
+}
@@ -0,0 +1,88 @@
+package erasure
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+)
+
+// matrix is a matrix over gf256 stored as a slice of rows.
+type matrix [][]byte
+
+// newMatrix returns the zero matrix with the given dimensions.
+func newMatrix(rows, cols int) matrix {
+	m := make(matrix, rows)
+	for i := range m {
+		m[i] = make([]byte, cols)
+	}
+	return m
+}
+
+// cauchyMatrix returns the rows×cols matrix with entries 1/(x_i+y_j), where
+// x_i = cols+i and y_j = j. Since all x_i and y_j are distinct, every square
+// submatrix is invertible. The number of rows and columns can be at most 256 in
+// total.
+func cauchyMatrix(rows, cols int) matrix {
+	m := newMatrix(rows, cols)
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] = inv(byte(cols+i) ^ byte(j))
+		}
+	}
+	return m
+}
+
+// invert returns the inverse of the square matrix m, which is left unchanged.
+// The inverse is computed using Gauss–Jordan elimination.
+//
+// If m is singular, an InputValue-error is returned.
+func (m matrix) invert() (matrix, error) {
+	const op = "Inverting matrix"
+
+	n := len(m)
+	work := newMatrix(n, 2*n)
+	for i := range m {
+		copy(work[i], m[i])
+		work[i][n+i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		// Find a pivot
+		pivot := col
+		for pivot < n && work[pivot][col] == 0 {
+			pivot++
+		}
+		if pivot == n {
+			return nil, errors.New(
+				op, errors.InputValue,
+				"Matrix is singular",
+			)
+		}
+		work[col], work[pivot] = work[pivot], work[col]
+
+		if c := work[col][col]; c != 1 {
+			mulSlice(inv(c), work[col], work[col])
+		}
+		for i := range work {
+			if i != col && work[i][col] != 0 {
+				mulAddSlice(work[i][col], work[col], work[i])
+			}
+		}
+	}
+
+	out := make(matrix, n)
+	for i := range work {
+		out[i] = work[i][n:]
+	}
+	return out, nil
+}
+
+// apply sets outputs[i] to the i'th entry of m times inputs, where inputs is
+// considered as a column vector of byte slices. All slices must have the same
+// length.
+func (m matrix) apply(inputs, outputs [][]byte) {
+	for i, row := range m {
+		mulSlice(row[0], inputs[0], outputs[i])
+		for j := 1; j < len(row); j++ {
+			mulAddSlice(row[j], inputs[j], outputs[i])
+		}
+	}
+}
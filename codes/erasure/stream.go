@@ -0,0 +1,292 @@
+package erasure
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/ReneBoedker/algobra/errors"
+)
+
+// streamBlockSize is the number of bytes read from each shard at a time when
+// streaming.
+const streamBlockSize = 64 * 1024
+
+// readBlocks reads the next block from each non-nil reader into the
+// corresponding buffer, and it returns the number of bytes read. A return value
+// smaller than streamBlockSize indicates that the end of the streams has been
+// reached.
+//
+// If the readers do not have the same length, an InputIncompatible-error is
+// returned. If reading fails, the error is wrapped in an Input-error.
+func readBlocks(op errors.Op, readers []io.Reader, bufs [][]byte) (int, error) {
+	n := -1
+	for i, r := range readers {
+		if r == nil {
+			continue
+		}
+		m, err := io.ReadFull(r, bufs[i])
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return 0, errors.Wrap(op, errors.Input, err)
+		}
+		if n == -1 {
+			n = m
+		} else if m != n {
+			return 0, errors.New(
+				op, errors.InputIncompatible,
+				"Shard %d has a different size than the previous shards", i,
+			)
+		}
+	}
+	if n == -1 {
+		n = 0
+	}
+	return n, nil
+}
+
+// newBlocks returns the given number of buffers of size streamBlockSize.
+func newBlocks(count int) [][]byte {
+	return newMatrix(count, streamBlockSize)
+}
+
+// truncate returns the buffers truncated to length n.
+func truncate(bufs [][]byte, n int) [][]byte {
+	out := make([][]byte, len(bufs))
+	for i, b := range bufs {
+		out[i] = b[:n]
+	}
+	return out
+}
+
+// StreamEncode reads the data shards from the given readers and writes the
+// parity shards to the given writers. All readers must provide the same number
+// of bytes.
+//
+// If the number of readers or writers is wrong, an InputValue-error is
+// returned. If the data shards have different sizes, an
+// InputIncompatible-error is returned. If reading or writing fails, the error
+// is wrapped in an Input-error.
+func (c *Code) StreamEncode(data []io.Reader, parity []io.Writer) error {
+	const op = "Encoding shard streams"
+
+	if len(data) != c.dataShards || len(parity) != c.parityShards {
+		return errors.New(
+			op, errors.InputValue,
+			"Expected %d data and %d parity shards, but received %d and %d",
+			c.dataShards, c.parityShards, len(data), len(parity),
+		)
+	}
+
+	in := newBlocks(c.dataShards)
+	out := newBlocks(c.parityShards)
+	for {
+		n, err := readBlocks(op, data, in)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+
+		outN := truncate(out, n)
+		c.parity.apply(truncate(in, n), outN)
+		for i, w := range parity {
+			if _, err := w.Write(outN[i]); err != nil {
+				return errors.Wrap(op, errors.Input, err)
+			}
+		}
+		if n < streamBlockSize {
+			return nil
+		}
+	}
+}
+
+// StreamVerify reads all shards from the given readers and reports whether the
+// parity shards are consistent with the data shards.
+//
+// If the number of readers is wrong, an InputValue-error is returned. If the
+// shards have different sizes, an InputIncompatible-error is returned. If
+// reading fails, the error is wrapped in an Input-error.
+func (c *Code) StreamVerify(shards []io.Reader) (bool, error) {
+	const op = "Verifying shard streams"
+
+	if len(shards) != c.Shards() {
+		return false, errors.New(
+			op, errors.InputValue,
+			"Expected %d shards, but received %d", c.Shards(), len(shards),
+		)
+	}
+	for i, r := range shards {
+		if r == nil {
+			return false, errors.New(
+				op, errors.InputValue,
+				"Shard %d is missing", i,
+			)
+		}
+	}
+
+	bufs := newBlocks(c.Shards())
+	computed := newBlocks(c.parityShards)
+	for {
+		n, err := readBlocks(op, shards, bufs)
+		if err != nil {
+			return false, err
+		}
+
+		bufsN, computedN := truncate(bufs, n), truncate(computed, n)
+		c.parity.apply(bufsN[:c.dataShards], computedN)
+		for i, s := range computedN {
+			if !bytes.Equal(s, bufsN[c.dataShards+i]) {
+				return false, nil
+			}
+		}
+		if n < streamBlockSize {
+			return true, nil
+		}
+	}
+}
+
+// StreamReconstruct recreates missing shards from streams. The i'th entry of
+// valid is a reader for the i'th shard, or nil if the shard is missing. The
+// reconstructed i'th shard is written to fill[i] if this is non-nil. At least
+// DataShards() readers must be non-nil, and fill[i] must be nil whenever
+// valid[i] is non-nil.
+//
+// If the number of readers or writers is wrong, if too few shards are present,
+// or if a writer is given for a present shard, an InputValue-error is returned.
+// If the present shards have different sizes, an InputIncompatible-error is
+// returned. If reading or writing fails, the error is wrapped in an
+// Input-error.
+func (c *Code) StreamReconstruct(valid []io.Reader, fill []io.Writer) error {
+	const op = "Reconstructing shard streams"
+
+	if len(valid) != c.Shards() || len(fill) != c.Shards() {
+		return errors.New(
+			op, errors.InputValue,
+			"Expected %d readers and writers, but received %d and %d",
+			c.Shards(), len(valid), len(fill),
+		)
+	}
+	for i := range valid {
+		if valid[i] != nil && fill[i] != nil {
+			return errors.New(
+				op, errors.InputValue,
+				"Shard %d is both present and to be reconstructed", i,
+			)
+		}
+	}
+	rec, err := c.planReconstruction(op, func(i int) bool {
+		return valid[i] != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	bufs := newBlocks(c.Shards())
+	shards := make([][]byte, c.Shards())
+	for {
+		n, err := readBlocks(op, valid, bufs)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+
+		for i := range shards {
+			if valid[i] != nil {
+				shards[i] = bufs[i][:n]
+			} else {
+				shards[i] = bufs[i][:0]
+			}
+		}
+		rec.apply(shards, n)
+		for i, w := range fill {
+			if w == nil {
+				continue
+			}
+			if _, err := w.Write(shards[i]); err != nil {
+				return errors.Wrap(op, errors.Input, err)
+			}
+		}
+		if n < streamBlockSize {
+			return nil
+		}
+	}
+}
+
+// StreamSplit reads size bytes from data and writes them to DataShards() data
+// shards of equal size. If necessary, the last data shard is padded with zeros.
+//
+// If the number of writers is wrong, if size is not positive, or if data
+// contains fewer than size bytes, an InputValue-error is returned. If reading
+// or writing fails, the error is wrapped in an Input-error.
+func (c *Code) StreamSplit(data io.Reader, dst []io.Writer, size int64) error {
+	const op = "Splitting data stream"
+
+	if len(dst) != c.dataShards {
+		return errors.New(
+			op, errors.InputValue,
+			"Expected %d data shards, but received %d", c.dataShards, len(dst),
+		)
+	}
+	if size <= 0 {
+		return errors.New(
+			op, errors.InputValue,
+			"Size must be positive",
+		)
+	}
+
+	k := int64(c.dataShards)
+	shardSize := (size + k - 1) / k
+	limited := &io.LimitedReader{R: data, N: size}
+	src := io.MultiReader(limited, bytes.NewReader(make([]byte, k*shardSize-size)))
+	for _, w := range dst {
+		if _, err := io.CopyN(w, src, shardSize); err != nil && err != io.EOF {
+			return errors.Wrap(op, errors.Input, err)
+		}
+	}
+	if limited.N > 0 {
+		return errors.New(
+			op, errors.InputValue,
+			"Data contains only %d of %d bytes", size-limited.N, size,
+		)
+	}
+	return nil
+}
+
+// StreamJoin reads the data shards from the given readers and writes the first
+// size bytes to dst. This reverses StreamSplit.
+//
+// If the number of readers is wrong, or if the data shards contain fewer than
+// size bytes, an InputValue-error is returned. If reading or writing fails, the
+// error is wrapped in an Input-error.
+func (c *Code) StreamJoin(dst io.Writer, shards []io.Reader, size int64) error {
+	const op = "Joining shard streams"
+
+	if len(shards) != c.dataShards {
+		return errors.New(
+			op, errors.InputValue,
+			"Expected %d data shards, but received %d", c.dataShards, len(shards),
+		)
+	}
+	for i, r := range shards {
+		if r == nil {
+			return errors.New(
+				op, errors.InputValue,
+				"Data shard %d is missing", i,
+			)
+		}
+	}
+
+	n, err := io.CopyN(dst, io.MultiReader(shards...), size)
+	switch {
+	case err == io.EOF:
+		return errors.New(
+			op, errors.InputValue,
+			"Data shards contain %d bytes, but %d were requested", n, size,
+		)
+	case err != nil:
+		return errors.Wrap(op, errors.Input, err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package erasure
+
+import (
+	"github.com/ReneBoedker/algobra/finitefield/binfield"
+)
+
+// gf256 is the field of 256 elements over which all codes are defined.
+var gf256 *binfield.Field
+
+// Lookup tables for arithmetic in gf256. Bytes are identified with field
+// elements via their bit representation (see binfield.Element.AsBits).
+//
+// The exponential table is stored twice to avoid reducing sums of logarithms
+// modulo 255.
+var (
+	expTable [2 * 255]byte
+	logTable [256]byte
+	mulTable [256][256]byte
+)
+
+func init() {
+	var err error
+	gf256, err = binfield.Define(256)
+	if err != nil {
+		// The field is hardcoded, so this cannot happen
+		panic(err)
+	}
+
+	gen := gf256.MultGenerator()
+	for i, e := 0, gf256.One(); i < 255; i, e = i+1, e.Mult(gen) {
+		v := byte(e.(*binfield.Element).AsBits())
+		expTable[i] = v
+		expTable[i+255] = v
+		logTable[v] = byte(i)
+	}
+
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			mulTable[a][b] = expTable[int(logTable[a])+int(logTable[b])]
+		}
+	}
+}
+
+// mul returns the product of a and b.
+func mul(a, b byte) byte {
+	return mulTable[a][b]
+}
+
+// inv returns the multiplicative inverse of a, which must be non-zero.
+func inv(a byte) byte {
+	return expTable[255-int(logTable[a])]
+}
+
+// mulSlice sets out[i] to c*in[i] for each i. The slice out must be at least as
+// long as in.
+func mulSlice(c byte, in, out []byte) {
+	row := &mulTable[c]
+	for i, v := range in {
+		out[i] = row[v]
+	}
+}
+
+// mulAddSlice adds c*in[i] to out[i] for each i. The slice out must be at least
+// as long as in.
+func mulAddSlice(c byte, in, out []byte) {
+	row := &mulTable[c]
+	for i, v := range in {
+		out[i] ^= row[v]
+	}
+}
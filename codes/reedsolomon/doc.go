@@ -0,0 +1,30 @@
+// Package reedsolomon implements Reed–Solomon codes over finite fields.
+//
+// A Reed–Solomon code of length n and dimension k is defined from a field and n
+// distinct points of the field, called the evaluation set. The codewords are the
+// evaluations of the polynomials of degree less than k in these points.
+//
+//	gf16, _ := finitefield.Define(16)
+//	code, err := reedsolomon.New(gf16, gf16.Elements()[1:], 5)
+//	if err != nil {
+//	    // New returns an error if the points are not distinct or if the
+//	    // dimension is invalid
+//	}
+//
+// # Encoding
+//
+// Messages can be encoded either non-systematically using Encode or
+// systematically using EncodeSystematic. In the first case, the message is the
+// coefficient vector of the polynomial that is evaluated. In the second case,
+// the message appears as the first k entries of the codeword.
+//
+// # Decoding
+//
+// The method Decode corrects errors and erasures using Gao's algorithm. If e
+// positions are marked as erasures, up to (n-e-k)/2 errors can be corrected.
+//
+// Beyond this radius, ListDecode returns the list of all codewords close to the
+// received word using the Guruswami–Sudan algorithm. The number of errors that
+// can be corrected depends on a multiplicity parameter and is given by
+// ListDecodingRadius.
+package reedsolomon
@@ -0,0 +1,84 @@
+package reedsolomon_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ReneBoedker/algobra/codes/reedsolomon"
+	"github.com/ReneBoedker/algobra/finitefield"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+func ExampleCode_Decode() {
+	gf7, _ := finitefield.Define(7)
+	code, err := reedsolomon.New(gf7, gf7.Elements()[1:], 2)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(code)
+
+	cw, _ := code.Encode([]ff.Element{
+		gf7.ElementFromUnsigned(1),
+		gf7.ElementFromUnsigned(2),
+	})
+	fmt.Println("Codeword:", cw)
+
+	// Introduce an error in the first position and erase the last position
+	received := append([]ff.Element{}, cw...)
+	received[0] = gf7.Zero()
+	received[5] = gf7.Zero()
+	fmt.Println("Received:", received)
+
+	corrected, err := code.Decode(received, []int{5})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("Decoded: ", corrected)
+	// Output:
+	// [6, 2] Reed–Solomon code over Finite field of 7 elements
+	// Codeword: [3 5 0 2 4 6]
+	// Received: [0 5 0 2 4 0]
+	// Decoded:  [3 5 0 2 4 6]
+}
+
+func ExampleCode_ListDecode() {
+	gf7, _ := finitefield.Define(7)
+	code, err := reedsolomon.New(gf7, gf7.Elements(), 2)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(code)
+
+	// Unique decoding corrects 2 errors, but list decoding corrects more
+	radius, _ := code.ListDecodingRadius(3)
+	fmt.Println("Radius:  ", radius)
+
+	cw, _ := code.Encode([]ff.Element{
+		gf7.ElementFromUnsigned(1),
+		gf7.ElementFromUnsigned(2),
+	})
+	fmt.Println("Codeword:", cw)
+
+	// Introduce errors in the first four positions
+	received := append([]ff.Element{}, cw...)
+	for i := 0; i < 4; i++ {
+		received[i] = gf7.Zero()
+	}
+	fmt.Println("Received:", received)
+
+	list, err := code.ListDecode(received, 3)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// The zero codeword is at distance 3 and is listed first
+	for _, c := range list {
+		fmt.Println("Decoded: ", c)
+	}
+	// Output:
+	// [7, 2] Reed–Solomon code over Finite field of 7 elements
+	// Radius:   4
+	// Codeword: [1 3 5 0 2 4 6]
+	// Received: [0 0 0 0 2 4 6]
+	// Decoded:  [0 0 0 0 0 0 0]
+	// Decoded:  [1 3 5 0 2 4 6]
+}
@@ -0,0 +1,164 @@
+package reedsolomon
+
+import (
+	"sort"
+
+	"github.com/ReneBoedker/algobra/bivariate"
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// listDecodingParams returns the parameters of the Guruswami–Sudan algorithm
+// with multiplicity m. The interpolation polynomial is supported on the
+// monomials X^iY^j with i+w*j at most maxDeg, and all codewords with at most
+// radius errors are found.
+func (c *Code) listDecodingParams(m int) (w, maxDeg uint, radius int) {
+	// For k=1, the weight k-1 would leave the degree in Y unbounded. Any weight
+	// of at least the degree of the message polynomials can be used instead
+	w = uint(c.k - 1)
+	if w == 0 {
+		w = 1
+	}
+
+	// The interpolation polynomial exists once the number of monomials exceeds
+	// the number of linear conditions
+	nEquations := c.Length() * m * (m + 1) / 2
+	for nMonomials := 1; nMonomials <= nEquations; {
+		maxDeg++
+		nMonomials += int(maxDeg/w) + 1
+	}
+
+	// If f has degree less than k and agrees with the received word in t
+	// positions, then Q(X,f(X)) has degree at most maxDeg and at least t*m
+	// roots counted with multiplicity. It is therefore zero if t*m > maxDeg
+	return w, maxDeg, c.Length() - int(maxDeg)/m - 1
+}
+
+// ListDecodingRadius returns the number of errors that ListDecode can correct
+// when using multiplicity m.
+//
+// For low rates and sufficiently large m, the radius exceeds the radius
+// (n-k)/2 of unique decoding. For high rates, it may be smaller.
+//
+// If m is not positive, an InputValue-error is returned.
+func (c *Code) ListDecodingRadius(m int) (int, error) {
+	const op = "Computing list decoding radius"
+
+	if m < 1 {
+		return 0, errors.New(
+			op, errors.InputValue,
+			"Multiplicity %d must be positive", m,
+		)
+	}
+	_, _, radius := c.listDecodingParams(m)
+	return radius, nil
+}
+
+// ListDecode returns all codewords that differ from the received word in at
+// most r positions, where r is given by ListDecodingRadius(m). The codewords
+// are sorted by their distance to the received word.
+//
+// The decoding uses the Guruswami–Sudan algorithm. First, a non-zero
+// polynomial Q(X,Y) is found that vanishes with multiplicity m in each of the
+// points (u,v), where u is in the evaluation set and v is the corresponding
+// entry of the received word. Its (1,k-1)-weighted degree is bounded such that
+// Q(X,f(X)) is zero whenever f has degree less than k and its evaluation is
+// close to the received word. The candidates f are therefore found among the
+// Y-roots of Q, which are computed using the algorithm of Roth and Ruckenstein.
+//
+// An InputValue-error is returned if received has the wrong length or if m is
+// not positive.
+func (c *Code) ListDecode(received []ff.Element, m int) ([][]ff.Element, error) {
+	// The implementation is based on V. Guruswami and M. Sudan, Improved
+	// decoding of Reed-Solomon and algebraic-geometry codes, 1999, and R. M.
+	// Roth and G. Ruckenstein, Efficient decoding of Reed-Solomon codes beyond
+	// half the minimum distance, 2000
+	const op = "List decoding received word"
+
+	if err := checkLength(op, received, c.Length(), "Received word"); err != nil {
+		return nil, err
+	}
+	if m < 1 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Multiplicity %d must be positive", m,
+		)
+	}
+	w, maxDeg, radius := c.listDecodingParams(m)
+
+	ring := bivariate.DefRing(c.field, bivariate.WDegLex(1, w, false))
+	points := make([][2]ff.Element, c.Length())
+	for i, u := range c.points {
+		points[i] = [2]ff.Element{u, received[i]}
+	}
+	support := make([][2]uint, 0)
+	for j := uint(0); j*w <= maxDeg; j++ {
+		for i := uint(0); i+j*w <= maxDeg; i++ {
+			support = append(support, [2]uint{i, j})
+		}
+	}
+
+	q, err := ring.VanishingPolynomial(points, uint(m), support)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	roots, err := q.YRoots(uint(c.k))
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	out := make([][]ff.Element, 0, len(roots))
+	dists := make([]int, 0, len(roots))
+	for _, f := range roots {
+		cw := c.ring.Polynomial(f.Coefs()).EvalMulti(c.points)
+		if d := distance(cw, received); d <= radius {
+			out = append(out, cw)
+			dists = append(dists, d)
+		}
+	}
+
+	// Sort by distance, and break ties using the string representations in
+	// order to make the output deterministic
+	sort.Sort(&byDistance{codewords: out, dists: dists})
+	return out, nil
+}
+
+// distance returns the Hamming distance between a and b, which must have equal
+// lengths.
+func distance(a, b []ff.Element) int {
+	d := 0
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			d++
+		}
+	}
+	return d
+}
+
+// byDistance sorts codewords by their distances to a fixed word.
+type byDistance struct {
+	codewords [][]ff.Element
+	dists     []int
+}
+
+func (s *byDistance) Len() int {
+	return len(s.codewords)
+}
+
+func (s *byDistance) Less(i, j int) bool {
+	if s.dists[i] != s.dists[j] {
+		return s.dists[i] < s.dists[j]
+	}
+	for l := range s.codewords[i] {
+		a, b := s.codewords[i][l].String(), s.codewords[j][l].String()
+		if a != b {
+			return a < b
+		}
+	}
+	return false
+}
+
+func (s *byDistance) Swap(i, j int) {
+	s.codewords[i], s.codewords[j] = s.codewords[j], s.codewords[i]
+	s.dists[i], s.dists[j] = s.dists[j], s.dists[i]
+}
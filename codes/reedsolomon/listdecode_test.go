@@ -0,0 +1,103 @@
+package reedsolomon
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/internal/testutil"
+)
+
+func TestListDecodingRadius(t *testing.T) {
+	field := testutil.DefineField(16)
+	code, _ := New(field, field.Elements()[1:], 4)
+
+	// Unique decoding corrects 5 errors. Sudan's algorithm (m=1) corrects 6,
+	// and increasing the multiplicity gives one more
+	for m, expected := range []int{6, 7, 7} {
+		if r, err := code.ListDecodingRadius(m + 1); err != nil {
+			t.Errorf("ListDecodingRadius returned an error: %q", err)
+		} else if r != expected {
+			t.Errorf("ListDecodingRadius(%d) of %v is %d, but expected %d",
+				m+1, code, r, expected)
+		}
+	}
+
+	if _, err := code.ListDecodingRadius(0); err == nil {
+		t.Errorf("ListDecodingRadius succeeded with multiplicity 0")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("ListDecodingRadius returned an error of unexpected kind "+
+			"(err = %v)", err)
+	}
+}
+
+func TestListDecode(t *testing.T) {
+	for _, card := range []uint{7, 16, 17} {
+		field := testutil.DefineField(card)
+		points := field.Elements()
+		n := len(points)
+		for rep := 0; rep < 10; rep++ {
+			k := prg.Intn(n/2) + 1
+			m := prg.Intn(3) + 1
+			code, err := New(field, points, k)
+			if err != nil {
+				t.Fatalf("Failed to define code: %q", err)
+			}
+			radius, _ := code.ListDecodingRadius(m)
+			if radius < 0 {
+				continue
+			}
+			cw, _ := code.Encode(testutil.RandomVector(field, k))
+
+			received := make([]ff.Element, n)
+			copy(received, cw)
+			for _, i := range prg.Perm(n)[:radius] {
+				received[i] = received[i].Plus(field.One())
+			}
+
+			list, err := code.ListDecode(received, m)
+			if err != nil {
+				t.Errorf("%v: ListDecode with multiplicity %d returned an "+
+					"error: %q", code, m, err)
+				continue
+			}
+			found := false
+			for i, c := range list {
+				found = found || testutil.EqualVectors(c, cw)
+				if _, err := code.Message(c); err != nil {
+					t.Errorf("%v: ListDecode returned %v, which is not a "+
+						"codeword", code, c)
+				}
+				d := distance(c, received)
+				if d > radius {
+					t.Errorf("%v: ListDecode returned %v at distance %d, "+
+						"which exceeds the radius %d", code, c, d, radius)
+				}
+				if i > 0 && distance(list[i-1], received) > d {
+					t.Errorf("%v: ListDecode returned unsorted list", code)
+				}
+			}
+			if !found {
+				t.Errorf("%v: ListDecode of %v with multiplicity %d returned "+
+					"%v, which does not contain %v", code, received, m, list,
+					cw)
+			}
+		}
+	}
+
+	field := testutil.DefineField(7)
+	code, _ := New(field, field.Elements(), 3)
+	cw, _ := code.Encode(testutil.RandomVector(field, 3))
+	if _, err := code.ListDecode(cw[1:], 1); err == nil {
+		t.Errorf("ListDecode succeeded for received word of wrong length")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("ListDecode returned an error of unexpected kind (err = %v)",
+			err)
+	}
+	if _, err := code.ListDecode(cw, 0); err == nil {
+		t.Errorf("ListDecode succeeded with multiplicity 0")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("ListDecode returned an error of unexpected kind (err = %v)",
+			err)
+	}
+}
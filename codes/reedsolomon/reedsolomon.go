@@ -0,0 +1,262 @@
+package reedsolomon
+
+import (
+	"fmt"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+// Code is a Reed–Solomon code of length n and dimension k. Its codewords are
+// the evaluations of the polynomials of degree less than k in the n points of
+// the evaluation set.
+type Code struct {
+	field  ff.Field
+	ring   *univariate.QuotientRing
+	points []ff.Element
+	k      int
+	ip     *univariate.Interpolator
+	sysIp  *univariate.Interpolator
+}
+
+// New defines the Reed–Solomon code of dimension k over the given field, using
+// points as the evaluation set. The length of the code is the number of points.
+//
+// If the points are not distinct or if k is not between 1 and the number of
+// points, an InputValue-error is returned.
+func New(field ff.Field, points []ff.Element, k int) (*Code, error) {
+	const op = "Defining Reed–Solomon code"
+
+	if k < 1 || k > len(points) {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Dimension %d must be between 1 and the length %d",
+			k, len(points),
+		)
+	}
+
+	ring := univariate.DefRing(field)
+	ip, err := ring.NewInterpolator(points)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	sysIp, err := ring.NewInterpolator(points[:k])
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	pointsCopy := make([]ff.Element, len(points))
+	for i, p := range points {
+		pointsCopy[i] = p.Copy()
+	}
+
+	return &Code{
+		field:  field,
+		ring:   ring,
+		points: pointsCopy,
+		k:      k,
+		ip:     ip,
+		sysIp:  sysIp,
+	}, nil
+}
+
+// String returns a string representation of c.
+func (c *Code) String() string {
+	return fmt.Sprintf(
+		"[%d, %d] Reed–Solomon code over %v", c.Length(), c.k, c.field,
+	)
+}
+
+// Field returns the field over which c is defined.
+func (c *Code) Field() ff.Field {
+	return c.field
+}
+
+// Length returns the length of c.
+func (c *Code) Length() int {
+	return len(c.points)
+}
+
+// Dimension returns the dimension of c.
+func (c *Code) Dimension() int {
+	return c.k
+}
+
+// MinDistance returns the minimum distance of c, which is n-k+1.
+func (c *Code) MinDistance() int {
+	return c.Length() - c.k + 1
+}
+
+// Points returns a copy of the evaluation set of c.
+func (c *Code) Points() []ff.Element {
+	out := make([]ff.Element, len(c.points))
+	for i, p := range c.points {
+		out[i] = p.Copy()
+	}
+	return out
+}
+
+// checkLength returns an InputValue-error if v does not have length n.
+func checkLength(op errors.Op, v []ff.Element, n int, desc string) error {
+	if len(v) != n {
+		return errors.New(
+			op, errors.InputValue,
+			"%s has length %d, but expected length %d", desc, len(v), n,
+		)
+	}
+	for _, e := range v {
+		if e.Err() != nil {
+			return errors.Wrap(op, errors.Inherit, e.Err())
+		}
+	}
+	return nil
+}
+
+// Encode returns the codeword corresponding to msg using non-systematic
+// encoding. That is, the codeword is the evaluation of the polynomial with
+// coefficients msg in the evaluation set.
+//
+// If msg does not have length k, an InputValue-error is returned.
+func (c *Code) Encode(msg []ff.Element) ([]ff.Element, error) {
+	const op = "Encoding message"
+
+	if err := checkLength(op, msg, c.k, "Message"); err != nil {
+		return nil, err
+	}
+	return c.ring.Polynomial(msg).EvalMulti(c.points), nil
+}
+
+// EncodeSystematic returns the codeword corresponding to msg using systematic
+// encoding. That is, the first k entries of the codeword are equal to msg.
+//
+// If msg does not have length k, an InputValue-error is returned.
+func (c *Code) EncodeSystematic(msg []ff.Element) ([]ff.Element, error) {
+	const op = "Encoding message systematically"
+
+	if err := checkLength(op, msg, c.k, "Message"); err != nil {
+		return nil, err
+	}
+	f, err := c.sysIp.Interpolate(msg)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	return f.EvalMulti(c.points), nil
+}
+
+// Message returns the message corresponding to the codeword under
+// non-systematic encoding. For systematic encoding, the message consists of the
+// first k entries of the codeword.
+//
+// If codeword is not a codeword of c, an InputValue-error is returned.
+func (c *Code) Message(codeword []ff.Element) ([]ff.Element, error) {
+	const op = "Computing message of codeword"
+
+	if err := checkLength(op, codeword, c.Length(), "Codeword"); err != nil {
+		return nil, err
+	}
+	f, err := c.ip.Interpolate(codeword)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	if f.Ld() >= c.k {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Input is not a codeword",
+		)
+	}
+
+	msg := make([]ff.Element, c.k)
+	for i := range msg {
+		msg[i] = f.Coef(i)
+	}
+	return msg, nil
+}
+
+// Decode corrects errors and erasures in the received word and returns the
+// closest codeword. The positions in erasures are ignored. If e erasures are
+// given, up to (n-e-k)/2 errors can be corrected.
+//
+// The decoding uses Gao's algorithm: the received word is interpolated, and a
+// partial extended Euclidean algorithm is applied to this interpolation
+// polynomial and the product of X-u for the non-erased points u.
+//
+// An InputValue-error is returned if received has the wrong length, if the
+// erasures are invalid, or if decoding fails because there are too many errors.
+func (c *Code) Decode(received []ff.Element, erasures []int) ([]ff.Element, error) {
+	// The implementation is based on S. Gao, A new algorithm for decoding
+	// Reed-Solomon codes, 2003
+	const op = "Decoding received word"
+
+	if err := checkLength(op, received, c.Length(), "Received word"); err != nil {
+		return nil, err
+	}
+
+	erased := make([]bool, c.Length())
+	for _, i := range erasures {
+		if i < 0 || i >= c.Length() || erased[i] {
+			return nil, errors.New(
+				op, errors.InputValue,
+				"Invalid or repeated erasure position %d", i,
+			)
+		}
+		erased[i] = true
+	}
+
+	points := make([]ff.Element, 0, c.Length()-len(erasures))
+	values := make([]ff.Element, 0, c.Length()-len(erasures))
+	for i := range c.points {
+		if !erased[i] {
+			points = append(points, c.points[i])
+			values = append(values, received[i])
+		}
+	}
+	n := len(points)
+	if n < c.k {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Too many erasures (%d) for a code of dimension %d",
+			len(erasures), c.k,
+		)
+	}
+
+	// Without erasures, the interpolator of the full evaluation set is reused
+	ip := c.ip
+	if len(erasures) > 0 {
+		var err error
+		ip, err = c.ring.NewInterpolator(points)
+		if err != nil {
+			return nil, errors.Wrap(op, errors.Inherit, err)
+		}
+	}
+
+	g1, err := ip.Interpolate(values)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	if g1.Ld() < c.k {
+		// No errors occurred
+		return g1.EvalMulti(c.points), nil
+	}
+	g0 := ip.VanishingPolynomial()
+
+	// Run the extended Euclidean algorithm until the remainder has degree
+	// less than (n+k)/2
+	r1, _, t1, err := univariate.PartialExtendedGcd(g0, g1, (n+c.k+1)/2)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	// The remainder is f times the error locator t1
+	f, rem, err := r1.QuoRem(t1)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	if rem.IsNonzero() || (f[0].IsNonzero() && f[0].Ld() >= c.k) {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Decoding failed since too many errors occurred",
+		)
+	}
+	return f[0].EvalMulti(c.points), nil
+}
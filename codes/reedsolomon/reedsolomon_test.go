@@ -0,0 +1,137 @@
+package reedsolomon
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/internal/testutil"
+)
+
+var prg = rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
+
+func TestNewErrors(t *testing.T) {
+	field := testutil.DefineField(7)
+	points := field.Elements()
+
+	for _, k := range []int{0, -1, 8} {
+		if _, err := New(field, points, k); err == nil {
+			t.Errorf("New succeeded with dimension %d", k)
+		} else if !errors.Is(errors.InputValue, err) {
+			t.Errorf("New returned an error of unexpected kind (err = %v)", err)
+		}
+	}
+
+	points[1] = points[0].Copy()
+	if _, err := New(field, points, 3); err == nil {
+		t.Errorf("New succeeded with repeated points")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("New returned an error of unexpected kind (err = %v)", err)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	for _, card := range []uint{7, 9, 16, 49} {
+		field := testutil.DefineField(card)
+		points := field.Elements()
+		for rep := 0; rep < 10; rep++ {
+			k := prg.Intn(len(points)) + 1
+			code, err := New(field, points, k)
+			if err != nil {
+				t.Fatalf("Failed to define code: %q", err)
+			}
+			if code.MinDistance() != len(points)-k+1 {
+				t.Errorf("%v has minimum distance %d", code, code.MinDistance())
+			}
+
+			msg := testutil.RandomVector(field, k)
+			cw, err := code.Encode(msg)
+			if err != nil {
+				t.Errorf("Encode returned an error: %q", err)
+				continue
+			}
+			if dec, err := code.Message(cw); err != nil {
+				t.Errorf("Message returned an error: %q", err)
+			} else if !testutil.EqualVectors(dec, msg) {
+				t.Errorf("Message of encoding of %v was %v", msg, dec)
+			}
+
+			cw, err = code.EncodeSystematic(msg)
+			if err != nil {
+				t.Errorf("EncodeSystematic returned an error: %q", err)
+				continue
+			}
+			if !testutil.EqualVectors(cw[:k], msg) {
+				t.Errorf("Systematic encoding of %v was %v", msg, cw)
+			}
+			if _, err := code.Message(cw); err != nil {
+				t.Errorf("Systematic encoding %v is not a codeword (err = %v)",
+					cw, err)
+			}
+		}
+
+		code, _ := New(field, points, 2)
+		if _, err := code.Encode(testutil.RandomVector(field, 3)); err == nil {
+			t.Errorf("Encode succeeded for message of wrong length")
+		} else if !errors.Is(errors.InputValue, err) {
+			t.Errorf("Encode returned an error of unexpected kind (err = %v)",
+				err)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	for _, card := range []uint{7, 9, 16, 49} {
+		field := testutil.DefineField(card)
+		points := field.Elements()
+		n := len(points)
+		for rep := 0; rep < 20; rep++ {
+			k := prg.Intn(n) + 1
+			code, err := New(field, points, k)
+			if err != nil {
+				t.Fatalf("Failed to define code: %q", err)
+			}
+			cw, _ := code.Encode(testutil.RandomVector(field, k))
+
+			// Choose erasures and errors within the decoding radius
+			perm := prg.Perm(n)
+			nErasures := prg.Intn(n - k + 1)
+			nErrors := (n - nErasures - k) / 2
+			erasures := perm[:nErasures]
+
+			received := make([]ff.Element, n)
+			copy(received, cw)
+			for _, i := range perm[nErasures : nErasures+nErrors] {
+				received[i] = received[i].Plus(field.One())
+			}
+			for _, i := range erasures {
+				received[i] = field.RandElement()
+			}
+
+			dec, err := code.Decode(received, erasures)
+			if err != nil {
+				t.Errorf("%v: Decode with %d erasures and %d errors returned "+
+					"an error: %q", code, nErasures, nErrors, err)
+			} else if !testutil.EqualVectors(dec, cw) {
+				t.Errorf("%v: Decoded %v as %v, but expected %v",
+					code, received, dec, cw)
+			}
+		}
+	}
+
+	field := testutil.DefineField(7)
+	code, _ := New(field, field.Elements(), 3)
+	cw, _ := code.Encode(testutil.RandomVector(field, 3))
+	if _, err := code.Decode(cw, []int{0, 0}); err == nil {
+		t.Errorf("Decode succeeded with repeated erasure")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("Decode returned an error of unexpected kind (err = %v)", err)
+	}
+	if _, err := code.Decode(cw, []int{0, 1, 2, 3, 4}); err == nil {
+		t.Errorf("Decode succeeded with too many erasures")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("Decode returned an error of unexpected kind (err = %v)", err)
+	}
+}
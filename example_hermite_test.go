@@ -3,8 +3,8 @@ package algobra_test
 import (
 	"fmt"
 
+	"github.com/ReneBoedker/algobra/bivariate"
 	"github.com/ReneBoedker/algobra/finitefield"
-	"github.com/ReneBoedker/algobra/finitefield/ff"
 )
 
 // The so-called places of the Hermitian function field over the finite field of
@@ -12,53 +12,19 @@ import (
 // It is well-known that there are q^3 such pairs, see for instance
 // [Stichtenoth, 2009].
 //
-// This example computes the pairs (α, β) for q=3.
+// This example computes the pairs (α, β) for q=3 as the rational points of the
+// Hermitian curve.
 func Example_hermitianPlaces() {
-	// Define the norm map given by N(a)=a^(q+1) in the Hermitian case
-	norm := func(field ff.Field, a ff.Element) ff.Element {
-		return a.Pow(field.Char() + 1)
-	}
-
-	// trInv computes the preimage of a with respect to the trace map. In the
-	// Hermitian case, the trace is given by Tr(a)=a^q+a.
-	trInv := func(field ff.Field, a ff.Element) ([]ff.Element, error) {
-		if !a.Equal(a.Pow(field.Char())) {
-			// The input must be in the image of the trace.
-			// That is, it must be in F_q
-			return nil, fmt.Errorf("%v is not in the image of the trace", a)
-		}
-
-		out := make([]ff.Element, 0, field.Char())
-		for _, b := range field.Elements() {
-			// Search for those elements that map to a under the trace map
-			if b.Trace().Equal(a) {
-				out = append(out, b)
-			}
-			// Stop searching once all q solutions have been found
-			if uint(len(out)) == field.Char() {
-				break
-			}
-		}
-
-		return out, nil
-	}
-
-	// Define the field and the list of places.
 	field, _ := finitefield.Define(9)
-	places := make([][2]ff.Element, 0, 27)
+	ring := bivariate.DefRing(field, bivariate.WDegLex(3, 4, false))
 
-	for _, a := range field.Elements() {
-		// Find the preimage of a
-		tmp, err := trInv(field, norm(field, a))
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
+	herm, _ := ring.PolynomialFromString("X^4 - Y^3 - Y")
+	id, _ := ring.NewIdeal(herm)
 
-		// Append the found pairs
-		for _, b := range tmp {
-			places = append(places, [2]ff.Element{a, b})
-		}
+	places, err := id.RationalPoints()
+	if err != nil {
+		fmt.Println(err)
+		return
 	}
 
 	for _, p := range places {
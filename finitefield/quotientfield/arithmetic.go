@@ -0,0 +1,218 @@
+package quotientfield
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// Add sets a to the sum of a and b. It then returns a.
+//
+// If a and b are defined over different fields, a new element is returned with
+// an ArithmeticIncompat-error as error status.
+//
+// When a or b has a non-nil error status, its error is wrapped and the same
+// element is returned.
+func (a *Element) Add(b ff.Element) ff.Element {
+	const op = "Adding elements"
+
+	bb, ok := b.(*Element)
+	if !ok {
+		a.err = errors.New(
+			op, errors.InputIncompatible,
+			"Cannot add %v (%[1]T) and %v (%[2]T)", a, b,
+		)
+		return a
+	}
+
+	if tmp := checkErrAndCompatible(op, a, bb); tmp != nil {
+		return tmp
+	}
+
+	a.val.Add(bb.val)
+	return a
+}
+
+// Plus returns the sum of elements a and b.
+//
+// If a and b are defined over different fields, a new element is returned with
+// an ArithmeticIncompat-error as error status.
+//
+// When a or b has a non-nil error status, its error is wrapped and the same
+// element is returned.
+func (a *Element) Plus(b ff.Element) ff.Element {
+	return a.Copy().Add(b)
+}
+
+// Sub sets a to the difference of elements a and b. It then returns a.
+//
+// If a and b are defined over different fields, a new element is returned with
+// an ArithmeticIncompat-error as error status.
+//
+// When a or b has a non-nil error status, its error is wrapped and the same
+// element is returned.
+func (a *Element) Sub(b ff.Element) ff.Element {
+	const op = "Subtracting elements"
+
+	bb, ok := b.(*Element)
+	if !ok {
+		a.err = errors.New(
+			op, errors.InputIncompatible,
+			"Cannot subtract %v (%[1]T) from %v (%[2]T)", b, a,
+		)
+		return a
+	}
+
+	if tmp := checkErrAndCompatible(op, a, bb); tmp != nil {
+		return tmp
+	}
+
+	a.val.Sub(bb.val)
+	return a
+}
+
+// Minus returns the difference of elements a and b.
+//
+// If a and b are defined over different fields, a new element is returned with
+// an ArithmeticIncompat-error as error status.
+//
+// When a or b has a non-nil error status, its error is wrapped and the same
+// element is returned.
+func (a *Element) Minus(b ff.Element) ff.Element {
+	return a.Copy().Sub(b)
+}
+
+// Prod sets a to the product of b and c. It then returns a.
+//
+// The function returns an ArithmeticIncompat-error if b, and c are not defined
+// over the same field.
+//
+// When b or c has a non-nil error status, its error is wrapped and the same
+// element is returned.
+func (a *Element) Prod(b, c ff.Element) ff.Element {
+	const op = "Multiplying elements"
+
+	bb, okB := b.(*Element)
+	cc, okC := c.(*Element)
+	if !okB || !okC {
+		a.err = errors.New(
+			op, errors.InputIncompatible,
+			"Cannot set type %T to product of %v (%[1]T) and %v (%[2]T)", a, b, c,
+		)
+		return a
+	}
+
+	if tmp := checkErrAndCompatible(op, bb, cc); tmp != nil {
+		return tmp
+	}
+
+	// Set the correct field of a
+	a.field = bb.field
+	a.val = bb.val.Times(cc.val)
+	return a
+}
+
+// Times returns the product of elements a and b.
+//
+// If a and b are defined over different fields, a new element is returned with
+// an ArithmeticIncompat-error as error status.
+//
+// When a or b has a non-nil error status, its error is wrapped and the same
+// element is returned.
+func (a *Element) Times(b ff.Element) ff.Element {
+	return a.Copy().Mult(b)
+}
+
+// Mult sets a to the product of elements a and b. It then returns a.
+//
+// If a and b are defined over different fields, a new element is returned with
+// an ArithmeticIncompat-error as error status.
+//
+// When a or b has a non-nil error status, its error is wrapped and the same
+// element is returned.
+func (a *Element) Mult(b ff.Element) ff.Element {
+	return a.Prod(a, b)
+}
+
+// Neg returns a scaled by negative one (modulo the characteristic).
+func (a *Element) Neg() ff.Element {
+	return a.Copy().SetNeg()
+}
+
+// SetNeg sets a to a scaled by negative one (modulo the characteristic). It
+// then returns a.
+func (a *Element) SetNeg() ff.Element {
+	a.val.SetNeg()
+	return a
+}
+
+// Pow returns a raised to the power of n.
+func (a *Element) Pow(n uint) ff.Element {
+	if a.IsZero() {
+		if n == 0 {
+			return a.field.One()
+		}
+		return a.field.Zero()
+	}
+
+	if n >= a.field.Card() {
+		// Use that a^(q-1)=1 for units
+		n = n % (a.field.Card() - 1)
+	}
+
+	out := a.field.One()
+	b := a.Copy()
+	for n > 0 {
+		if n%2 == 1 {
+			out.Mult(b)
+		}
+		n /= 2
+		b.Mult(b)
+	}
+	return out
+}
+
+// Inv returns the inverse of a.
+//
+// If a is the zero element, the return value is an element with
+// InputValue-error as error status.
+func (a *Element) Inv() ff.Element {
+	const op = "Inverting element"
+
+	if a.IsZero() {
+		o := a.field.Zero()
+		out := o.(*Element)
+		out.err = errors.New(
+			op, errors.InputValue,
+			"Cannot invert zero element",
+		)
+		return out
+	}
+
+	inv := a.val.Inv()
+	if inv.Err() != nil {
+		o := a.field.Zero()
+		out := o.(*Element)
+		out.err = errors.Wrap(op, errors.Inherit, inv.Err())
+		return out
+	}
+
+	return &Element{
+		field: a.field,
+		val:   inv,
+	}
+}
+
+// Trace computes the field trace of a over the prime field.
+//
+// The resulting object is considered as an element of the extension field (i.e.
+// the same field as a).
+func (a *Element) Trace() ff.Element {
+	out := a.Copy()
+
+	for i := a.field.Char(); i < a.field.Card(); i *= a.field.Char() {
+		out = out.Pow(a.field.Char())
+		out.Add(a)
+	}
+
+	return out
+}
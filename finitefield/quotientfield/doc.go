@@ -0,0 +1,51 @@
+// Package quotientfield implements finite fields defined as univariate
+// quotient rings modulo irreducible polynomials.
+//
+// The base field can be any implementation of ff.Field. In particular, the
+// base field may itself be a quotient field, which allows towers of field
+// extensions.
+//
+//	gf4, _ := finitefield.Define(4)
+//	ring := univariate.DefRing(gf4)
+//	ring.SetVarName("b")
+//
+//	g, _ := ring.PolynomialFromString("b^2 + b + a")
+//	id, _ := ring.NewIdeal(g)
+//	qRing, _ := ring.Quotient(id)
+//
+//	gf16, err := quotientfield.Define(qRing)
+//	if err != nil {
+//	    // Define returns an error if the ideal generator is not irreducible
+//	}
+//
+// Since the resulting field implements ff.Field, univariate and bivariate
+// polynomial rings can be defined over it. When doing so, choose variable names
+// that differ from those of the quotient ring and its base field in order for
+// string parsing to work.
+//
+// # Arithmetic operations
+//
+// The Element objects have methods Add, Sub, Mult, and Inv for the
+// basic field operations. Of these four, only Inv allocates a new object. The
+// other methods store the result in the receiving element object. For instance,
+// a.Add(b) would evaluate the sum a+b, and then set a to this value. If
+// the result is to be stored in a new object, the package provides the methods
+// Plus, Minus, and Times, which evaluate the arithmetic operation and
+// returns the result in a new object.
+//
+// Additional functions such as Neg and Pow are also defined.
+//
+// # Equality testing
+//
+// To test if two elements a and b are equal, a == b will not work since this
+// compares pointers rather than the underlying data. Instead, use a.Equal(b).
+// In addition, the expressions a.IsZero(), a.IsNonzero(), and a.IsOne() provide
+// shorthands for common comparisons.
+//
+// # Error handling
+//
+// In order to allow method chaining for arithmetic operations -- such as
+// a.Add(b).Mult(c.Inv()) -- the methods themselves do not return errors.
+// Instead, potential errors are tied to the resulting field element, and the
+// error can be retrieved with the Err-method.
+package quotientfield
@@ -0,0 +1,211 @@
+package quotientfield
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+// Ensure that Element implements the ff.Element interface
+var _ ff.Element = &Element{}
+
+// Element is the implementation of an element in a quotient field.
+type Element struct {
+	field *Field
+	val   *univariate.Polynomial
+	err   error
+}
+
+// Zero returns the additive identity in f.
+func (f *Field) Zero() ff.Element {
+	return &Element{
+		field: f,
+		val:   f.polyRing.Zero(),
+	}
+}
+
+// One returns the multiplicative identity in f.
+func (f *Field) One() ff.Element {
+	return &Element{
+		field: f,
+		val:   f.polyRing.One(),
+	}
+}
+
+// RandElement returns a pseudo-random element in f.
+//
+// The coefficients are chosen using the RandElement method of the base field.
+// Hence, the pseudo-random generator is not cryptographically safe unless the
+// one of the base field is.
+func (f *Field) RandElement() ff.Element {
+	coefs := make([]ff.Element, f.extDeg, f.extDeg)
+	for i := range coefs {
+		coefs[i] = f.baseField.RandElement()
+	}
+
+	return f.ElementFromSlice(coefs)
+}
+
+// Element defines a new element over f with value val, which must be either
+// uint, int, []uint, []int, []ff.Element, or string.
+//
+// If type of val is unsupported, the function returns an Input-error.
+func (f *Field) Element(val interface{}) (ff.Element, error) {
+	const op = "Defining element"
+
+	switch v := val.(type) {
+	case uint:
+		return f.ElementFromUnsigned(v), nil
+	case int:
+		return f.ElementFromSigned(v), nil
+	case []uint:
+		return &Element{field: f, val: f.polyRing.PolynomialFromUnsigned(v)}, nil
+	case []int:
+		return &Element{field: f, val: f.polyRing.PolynomialFromSigned(v)}, nil
+	case []ff.Element:
+		return f.ElementFromSlice(v), nil
+	case string:
+		return f.ElementFromString(v)
+	default:
+		return nil, errors.New(
+			op, errors.Input,
+			"Cannot define element in %v from type %T", f, v,
+		)
+	}
+}
+
+// ElementFromUnsigned defines a new element over f with value specified by val.
+//
+// The returned element will automatically be reduced modulo the characteristic.
+func (f *Field) ElementFromUnsigned(val uint) ff.Element {
+	return &Element{
+		field: f,
+		val:   f.polyRing.PolynomialFromUnsigned([]uint{val}),
+	}
+}
+
+// ElementFromSigned defines a new element over f with value specified by val.
+//
+// The returned element will be reduced modulo the characteristic automatically.
+// Negative values are reduced to a positive remainder (as opposed to the
+// %-operator in Go).
+func (f *Field) ElementFromSigned(val int) ff.Element {
+	return &Element{
+		field: f,
+		val:   f.polyRing.PolynomialFromSigned([]int{val}),
+	}
+}
+
+// ElementFromSlice defines a new element over f whose coefficients in the base
+// field are given by val.
+//
+// The returned element will automatically be reduced modulo the defining
+// polynomial.
+func (f *Field) ElementFromSlice(val []ff.Element) ff.Element {
+	return &Element{
+		field: f,
+		val:   f.polyRing.Polynomial(val),
+	}
+}
+
+// ElementFromString defines a new element over f from the given string.
+//
+// A Parsing-error is returned if the string cannot be parsed.
+func (f *Field) ElementFromString(val string) (ff.Element, error) {
+	const op = "Defining element from string"
+
+	v, err := f.polyRing.PolynomialFromString(val)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Parsing, err)
+	}
+
+	return &Element{
+		field: f,
+		val:   v,
+	}, nil
+}
+
+// Copy returns a copy of a.
+func (a *Element) Copy() ff.Element {
+	return &Element{
+		field: a.field,
+		val:   a.val.Copy(),
+		err:   a.err,
+	}
+}
+
+// Err returns the error status of a.
+func (a *Element) Err() error {
+	return a.err
+}
+
+// SetUnsigned sets the value of a to the element corresponding to val. It then
+// returns a.
+//
+// The value is automatically reduced modulo the characteristic.
+func (a *Element) SetUnsigned(val uint) ff.Element {
+	a.val = a.field.polyRing.PolynomialFromUnsigned([]uint{val})
+	return a
+}
+
+// Equal tests equality of elements a and b.
+func (a *Element) Equal(b ff.Element) bool {
+	bb, ok := b.(*Element)
+	if !ok {
+		return false
+	}
+
+	if a.field == bb.field && a.val.Equal(bb.val) {
+		return true
+	}
+	return false
+}
+
+// IsZero returns a boolean describing whether a is the additive identity.
+func (a *Element) IsZero() bool {
+	return a.val.IsZero()
+}
+
+// IsNonzero returns a boolean describing whether a is a non-zero element.
+func (a *Element) IsNonzero() bool {
+	return a.val.IsNonzero()
+}
+
+// IsOne returns a boolean describing whether a is the multiplicative identity.
+func (a *Element) IsOne() bool {
+	return a.val.IsOne()
+}
+
+// AsSlice returns the coefficients of a when expanded over the base field.
+func (a *Element) AsSlice() []ff.Element {
+	return a.val.Coefs()
+}
+
+// AsPolynomial returns a copy of the polynomial representing a.
+func (a *Element) AsPolynomial() *univariate.Polynomial {
+	return a.val.Copy()
+}
+
+// String returns the string representation of a.
+func (a *Element) String() string {
+	if a.val.Ld() == 0 {
+		// Avoid parentheses around constants from the base field
+		return a.val.Coef(0).String()
+	}
+	return a.val.String()
+}
+
+// NTerms returns the number of terms in the representation of a when expanded
+// over the prime field.
+func (a *Element) NTerms() uint {
+	if a.IsZero() {
+		return 1
+	}
+	n := uint(0)
+	for _, c := range a.val.Coefs() {
+		if c.IsNonzero() {
+			n += c.NTerms()
+		}
+	}
+	return n
+}
@@ -0,0 +1,28 @@
+package quotientfield_test
+
+import (
+	"fmt"
+
+	"github.com/ReneBoedker/algobra/finitefield"
+	"github.com/ReneBoedker/algobra/finitefield/quotientfield"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func ExampleDefine() {
+	gf4, _ := finitefield.Define(4)
+	ring := univariate.DefRing(gf4)
+	ring.SetVarName("b")
+
+	g, _ := ring.PolynomialFromString("b^2 + b + a")
+	id, _ := ring.NewIdeal(g)
+	qRing, _ := ring.Quotient(id)
+
+	gf16, _ := quotientfield.Define(qRing)
+	fmt.Println(gf16)
+
+	c, _ := gf16.ElementFromString("ab + 1")
+	fmt.Println(c.Inv())
+	// Output:
+	// Finite field of 16 elements
+	// b + a
+}
@@ -0,0 +1,245 @@
+package quotientfield
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp"
+	"time"
+
+	"github.com/ReneBoedker/algobra/auxmath"
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func init() {
+	// Set a new seed for the pseudo-random generator.
+	// Note that this is not cryptographically safe.
+	rand.Seed(time.Now().UTC().UnixNano())
+}
+
+// Field is the implementation of a finite field defined as a univariate
+// quotient ring modulo an irreducible polynomial.
+type Field struct {
+	baseField ff.Field
+	polyRing  *univariate.QuotientRing
+	extDeg    uint
+	card      uint
+	multGen   *Element
+}
+
+// Ensure that quotient fields satisfy the ff.Field interface
+var _ ff.Field = &Field{}
+
+// Define creates a new finite field from the quotient ring r.
+//
+// The ring r must be a quotient modulo an irreducible polynomial. Otherwise,
+// the function returns an InputValue-error. If the cardinality of the field
+// overflows uint, the function returns an InputTooLarge-error.
+//
+// Elements of the field are represented by polynomials in the variable of r.
+// If the field is used as the base field of polynomial rings, these should use
+// variable names different from both r and its base field.
+func Define(r *univariate.QuotientRing) (*Field, error) {
+	const op = "Defining quotient field"
+
+	id := r.Ideal()
+	if id == nil {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"%v is not a proper quotient ring", r,
+		)
+	}
+
+	gen := id.Generator()
+	if !gen.IsIrreducible() {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"%v is not irreducible over %v", gen, r.BaseField(),
+		)
+	}
+
+	extDeg := uint(gen.Ld())
+	card, err := auxmath.Pow(r.BaseField().Card(), extDeg)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.InputTooLarge, err)
+	}
+
+	return &Field{
+		baseField: r.BaseField(),
+		polyRing:  r,
+		extDeg:    extDeg,
+		card:      card,
+		multGen:   nil,
+	}, nil
+}
+
+// String returns the string representation of f.
+func (f *Field) String() string {
+	return fmt.Sprintf("Finite field of %d elements", f.Card())
+}
+
+// BaseField returns the field over which f is defined.
+func (f *Field) BaseField() ff.Field {
+	return f.baseField
+}
+
+// ExtDeg returns the extension degree of f over its base field.
+func (f *Field) ExtDeg() uint {
+	return f.extDeg
+}
+
+// Char returns the characteristic of f.
+func (f *Field) Char() uint {
+	return f.baseField.Char()
+}
+
+// Card returns the cardinality of f.
+func (f *Field) Card() uint {
+	return f.card
+}
+
+// MultGenerator returns an element that generates the units of f.
+//
+// The generator is found by search the first time the method is called.
+// Subsequent calls return a copy of the same element.
+func (f *Field) MultGenerator() ff.Element {
+	if f.multGen != nil {
+		return f.multGen.Copy()
+	}
+
+	// The possible orders of elements divide card-1
+	factors, _ := auxmath.Factorize(f.card - 1)
+	baseElems := f.baseField.Elements()
+
+	var e *Element
+outer:
+	for i := uint(1); i < f.card; i++ {
+		e = f.elementFromIndex(i, baseElems)
+		for _, p := range factors {
+			if e.Pow((f.card - 1) / p).IsOne() {
+				// Not a generator
+				continue outer
+			}
+		}
+		break
+	}
+	f.multGen = e
+	return e.Copy()
+}
+
+// elementFromIndex returns the element whose coefficients are given by the
+// base-q digits of i, where q is the cardinality of the base field. The digits
+// are interpreted as indices into baseElems.
+func (f *Field) elementFromIndex(i uint, baseElems []ff.Element) *Element {
+	q := f.baseField.Card()
+	coefs := make([]ff.Element, f.extDeg)
+	for j := range coefs {
+		coefs[j] = baseElems[i%q]
+		i /= q
+	}
+	return &Element{
+		field: f,
+		val:   f.polyRing.Polynomial(coefs),
+	}
+}
+
+// Elements returns a slice containing all elements of f.
+func (f *Field) Elements() []ff.Element {
+	out := make([]ff.Element, f.Card(), f.Card())
+	out[0] = f.Zero()
+
+	gen := f.MultGenerator()
+	for i, e := uint(1), f.One(); i < f.Card(); i, e = i+1, e.Mult(gen) {
+		out[i] = e.Copy()
+	}
+	return out
+}
+
+// RegexElement returns a string containing a regular expression describing an
+// element of f.
+//
+// The input argument requireParens indicates whether parentheses should be
+// required around elements containing several terms.
+func (f *Field) RegexElement(requireParens bool) string {
+	coefPattern := f.baseField.RegexElement(true)
+	varPattern := `(?i:` + regexp.QuoteMeta(f.polyRing.VarName()) + `)`
+
+	termPattern := `(?:` +
+		`(?:` + coefPattern + `)?\s*\*?\s*` + varPattern + `(?:\^?[0-9]+)?|` +
+		coefPattern + `)`
+	moreTerms := `(?:` + // Optional group of additional terms consisting of
+		`\s*(?:\+|-)\s*` + // a sign
+		termPattern + // and a term
+		`)*`
+
+	var pattern string
+
+	if requireParens {
+		pattern = `(?:\(\s*` + termPattern + moreTerms + `\s*\)|` + // several
+			// terms in parentheses
+			termPattern + `)` // Or single term
+
+	} else {
+		pattern = termPattern + moreTerms
+	}
+
+	return pattern
+}
+
+// checkErrAndCompatible is a wrapper for the two functions hasErr and
+// checkCompatible. It is used in arithmetic functions to check that the inputs
+// are 'good' to use.
+func checkErrAndCompatible(op errors.Op, a, b *Element) *Element {
+	if tmp := hasErr(op, a, b); tmp != nil {
+		return tmp
+	}
+
+	if tmp := checkCompatible(op, a, b); tmp != nil {
+		return tmp
+	}
+
+	return nil
+}
+
+// hasErr is an internal method for checking if a or b has a non-nil error
+// field.
+//
+// It returns the first element with non-nil error status after wrapping the
+// error. The new error inherits the kind from the old.
+func hasErr(op errors.Op, a, b *Element) *Element {
+	switch {
+	case a.err != nil:
+		a.err = errors.Wrap(
+			op, errors.Inherit,
+			a.err,
+		)
+		return a
+	case b.err != nil:
+		b.err = errors.Wrap(
+			op, errors.Inherit,
+			b.err,
+		)
+		return b
+	default:
+		return nil
+	}
+}
+
+// checkCompatible is an internal method for checking if a and b are compatible;
+// that is, if they are defined over the same field.
+//
+// If not, the return value is an element with error status set to
+// ArithmeticIncompat.
+func checkCompatible(op errors.Op, a, b *Element) *Element {
+	if a.field != b.field {
+		o := a.field.Zero()
+		out := o.(*Element)
+		out.err = errors.New(
+			op, errors.ArithmeticIncompat,
+			"%v and %v defined over different fields", a, b,
+		)
+		return out
+	}
+	return nil
+}
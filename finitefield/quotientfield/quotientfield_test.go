@@ -0,0 +1,214 @@
+package quotientfield
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/bivariate"
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+// defineQuotient returns the quotient of the polynomial ring over GF(card)
+// modulo the polynomial described by s.
+func defineQuotient(card uint, varName, s string) *univariate.QuotientRing {
+	base, err := finitefield.Define(card)
+	if err != nil {
+		// Testing code is wrong, so panic
+		panic(err)
+	}
+	ring := univariate.DefRing(base)
+	if err := ring.SetVarName(varName); err != nil {
+		panic(err)
+	}
+	g, err := ring.PolynomialFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	id, err := ring.NewIdeal(g)
+	if err != nil {
+		panic(err)
+	}
+	qr, err := ring.Quotient(id)
+	if err != nil {
+		panic(err)
+	}
+	return qr
+}
+
+// defineField returns the quotient field described by the inputs.
+func defineField(card uint, varName, s string) *Field {
+	f, err := Define(defineQuotient(card, varName, s))
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+func TestDefineErrors(t *testing.T) {
+	base, _ := finitefield.Define(5)
+	_, err := Define(univariate.DefRing(base))
+	if err == nil {
+		t.Errorf("Define returned no error for a ring without ideal")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("Define returned an error of unexpected kind (err = %v)", err)
+	}
+
+	// X^2 - 1 = (X-1)(X+1) is reducible
+	_, err = Define(defineQuotient(5, "X", "X^2 - 1"))
+	if err == nil {
+		t.Errorf("Define returned no error for a reducible polynomial")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("Define returned an error of unexpected kind (err = %v)", err)
+	}
+}
+
+func TestFieldProperties(t *testing.T) {
+	fields := []*Field{
+		defineField(2, "X", "X^4 + X + 1"),
+		defineField(3, "X", "X^2 + 1"),
+		defineField(4, "b", "b^2 + b + a"),
+		defineField(9, "b", "b^3 + b + a"),
+		defineField(7, "X", "X^3 + 3"),
+	}
+	expCard := []uint{16, 9, 16, 729, 343}
+
+	for i, field := range fields {
+		if field.Card() != expCard[i] {
+			t.Errorf("%v has cardinality %d, but expected %d",
+				field, field.Card(), expCard[i])
+		}
+
+		elems := field.Elements()
+		if uint(len(elems)) != field.Card() {
+			t.Errorf("Elements of %v returned %d elements", field, len(elems))
+		}
+		unique := make(map[string]struct{}, len(elems))
+		for _, e := range elems {
+			unique[e.String()] = struct{}{}
+		}
+		if uint(len(unique)) != field.Card() {
+			t.Errorf(
+				"Elements of %v contains only %d distinct elements",
+				field, len(unique),
+			)
+		}
+
+		for _, e := range elems {
+			if e.IsZero() {
+				continue
+			}
+			if prod := e.Times(e.Inv()); !prod.IsOne() {
+				t.Errorf("(%v) * (%v)^(-1) = %v in %v", e, e, prod, field)
+			}
+			if pow := e.Pow(field.Card() - 1); !pow.IsOne() {
+				t.Errorf("(%v)^%d = %v in %v", e, field.Card()-1, pow, field)
+			}
+			if tr := e.Trace(); !tr.Pow(field.Char()).Equal(tr) {
+				t.Errorf("Trace of %v is %v, which is not in the prime field",
+					e, tr)
+			}
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	field := defineField(4, "b", "b^2 + b + a")
+	for rep := 0; rep < 200; rep++ {
+		a := field.RandElement()
+		b := field.RandElement()
+		c := field.RandElement()
+
+		// Distributivity
+		lhs := a.Times(b.Plus(c))
+		rhs := a.Times(b).Plus(a.Times(c))
+		if !lhs.Equal(rhs) {
+			t.Errorf("(%v)((%v) + (%v)) = %v, but (%[1]v)(%[2]v) + "+
+				"(%[1]v)(%[3]v) = %v", a, b, c, lhs, rhs)
+		}
+
+		if !a.Minus(b).Plus(b).Equal(a) {
+			t.Errorf("(%v) - (%v) + (%[2]v) did not equal %[1]v", a, b)
+		}
+
+		if !a.Plus(a.Neg()).IsZero() {
+			t.Errorf("(%v) + (-(%[1]v)) is nonzero", a)
+		}
+	}
+
+	if err := field.Zero().Inv().Err(); err == nil {
+		t.Errorf("Inverting zero did not return an error")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("Inverting zero returned error of unexpected kind (err = %v)",
+			err)
+	}
+
+	other := defineField(4, "b", "b^2 + b + a")
+	if err := field.One().Plus(other.One()).Err(); err == nil {
+		t.Errorf("Adding elements from different fields returned no error")
+	} else if !errors.Is(errors.ArithmeticIncompat, err) {
+		t.Errorf("Adding elements from different fields returned error of "+
+			"unexpected kind (err = %v)", err)
+	}
+}
+
+func TestMultGenerator(t *testing.T) {
+	field := defineField(3, "X", "X^4 + X^3 + 2")
+	gen := field.MultGenerator()
+
+	seen := make(map[string]struct{})
+	for i, e := uint(0), field.One(); i < field.Card()-1; i, e = i+1, e.Mult(gen) {
+		seen[e.String()] = struct{}{}
+	}
+	if uint(len(seen)) != field.Card()-1 {
+		t.Errorf("%v generates only %d units of %v", gen, len(seen), field)
+	}
+}
+
+func TestStringRoundTrip(t *testing.T) {
+	field := defineField(4, "b", "b^2 + b + a")
+
+	for _, e := range field.Elements() {
+		f, err := field.ElementFromString(e.String())
+		if err != nil {
+			t.Errorf("Parsing %q returned an error: %q", e, err)
+		} else if !f.Equal(e) {
+			t.Errorf("Parsing %q gave %v", e, f)
+		}
+	}
+}
+
+func TestPolynomialRings(t *testing.T) {
+	field := defineField(4, "b", "b^2 + b + a")
+
+	uRing := univariate.DefRing(field)
+	for rep := 0; rep < 20; rep++ {
+		coefs := make([]ff.Element, 4)
+		for i := range coefs {
+			coefs[i] = field.RandElement()
+		}
+		f := uRing.Polynomial(coefs)
+		g, err := uRing.PolynomialFromString(f.String())
+		if err != nil {
+			t.Errorf("Parsing %q returned an error: %q", f, err)
+		} else if !f.Equal(g) {
+			t.Errorf("Parsing %q gave %v", f, g)
+		}
+	}
+
+	bRing := bivariate.DefRing(field, bivariate.DegLex(true))
+	for rep := 0; rep < 20; rep++ {
+		f := bRing.Polynomial(map[[2]uint]ff.Element{
+			{0, 0}: field.RandElement(),
+			{1, 0}: field.RandElement(),
+			{1, 2}: field.RandElement(),
+		})
+		g, err := bRing.PolynomialFromString(f.String())
+		if err != nil {
+			t.Errorf("Parsing %q returned an error: %q", f, err)
+		} else if !f.Equal(g) {
+			t.Errorf("Parsing %q gave %v", f, g)
+		}
+	}
+}
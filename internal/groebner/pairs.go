@@ -0,0 +1,200 @@
+// Package groebner contains the bookkeeping of critical pairs that is shared by
+// the Gröbner basis computations of the polynomial packages. Monomials are
+// represented by their exponent vectors.
+package groebner
+
+// Lcm returns the least common multiple of the monomials with degrees a and b.
+func Lcm(a, b []uint) []uint {
+	out := make([]uint, len(a))
+	for i := range a {
+		if a[i] > b[i] {
+			out[i] = a[i]
+		} else {
+			out[i] = b[i]
+		}
+	}
+	return out
+}
+
+// Divides determines whether the monomial with degree a divides the monomial
+// with degree b.
+func Divides(a, b []uint) bool {
+	for i := range a {
+		if a[i] > b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Coprime determines whether the monomials with degrees a and b are coprime.
+func Coprime(a, b []uint) bool {
+	for i := range a {
+		if a[i] != 0 && b[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal determines whether the degrees a and b are equal.
+func Equal(a, b []uint) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Pair is a critical pair of indices into a list of polynomials together with
+// the least common multiple of their leading monomials.
+type Pair struct {
+	I, J int
+	Lcm  []uint
+}
+
+// Stats counts the critical pairs that were formed and discarded.
+type Stats struct {
+	Pairs   int // Number of critical pairs formed
+	Coprime int // Pairs discarded by the product criterion
+	Chain   int // Pairs discarded by the chain criterion
+}
+
+// PairSet holds the critical pairs of a list of polynomials that have not yet
+// been treated. Only the leading monomials of the polynomials are stored.
+type PairSet struct {
+	ord   func(a, b []uint) int
+	lds   [][]uint
+	pairs []Pair
+	Stats Stats
+}
+
+// NewPairSet returns an empty set of critical pairs. The monomial order ord is
+// used for selecting pairs.
+func NewPairSet(ord func(a, b []uint) int) *PairSet {
+	return &PairSet{
+		ord:   ord,
+		lds:   make([][]uint, 0),
+		pairs: make([]Pair, 0),
+	}
+}
+
+// Len returns the number of pairs in s.
+func (s *PairSet) Len() int {
+	return len(s.pairs)
+}
+
+// Update adds a polynomial with leading monomial hLd to the list. Its index is
+// the number of previously added polynomials. The critical pairs are updated
+// using the criteria of Gebauer and Möller (see [BW93; Algorithm UPDATE]).
+func (s *PairSet) Update(hLd []uint) {
+	hIdx := len(s.lds)
+
+	// Form the new pairs and discard those whose lcm is divisible by the lcm
+	// of another new pair. Pairs with coprime leading monomials are kept for
+	// now, since they may render other pairs superfluous.
+	cands := make([]Pair, len(s.lds))
+	for i, ld := range s.lds {
+		cands[i] = Pair{I: i, J: hIdx, Lcm: Lcm(ld, hLd)}
+	}
+	s.Stats.Pairs += len(cands)
+
+	kept := make([]Pair, 0, len(cands))
+	for k, p := range cands {
+		if Coprime(s.lds[p.I], hLd) {
+			kept = append(kept, p)
+			continue
+		}
+		redundant := false
+		for _, q := range cands[k+1:] {
+			if Divides(q.Lcm, p.Lcm) {
+				redundant = true
+				break
+			}
+		}
+		for _, q := range kept {
+			if redundant {
+				break
+			}
+			if Divides(q.Lcm, p.Lcm) {
+				redundant = true
+			}
+		}
+		if redundant {
+			s.Stats.Chain++
+		} else {
+			kept = append(kept, p)
+		}
+	}
+
+	// Apply the product criterion
+	newPairs := make([]Pair, 0, len(kept))
+	for _, p := range kept {
+		if Coprime(s.lds[p.I], hLd) {
+			s.Stats.Coprime++
+		} else {
+			newPairs = append(newPairs, p)
+		}
+	}
+
+	// Discard old pairs for which the leading monomial of h gives a chain
+	oldPairs := s.pairs[:0]
+	for _, p := range s.pairs {
+		if Divides(hLd, p.Lcm) &&
+			!Equal(Lcm(s.lds[p.I], hLd), p.Lcm) &&
+			!Equal(Lcm(s.lds[p.J], hLd), p.Lcm) {
+			s.Stats.Chain++
+			continue
+		}
+		oldPairs = append(oldPairs, p)
+	}
+
+	s.pairs = append(oldPairs, newPairs...)
+	s.lds = append(s.lds, hLd)
+}
+
+// Next removes and returns the pair with the smallest lcm according to the
+// monomial order. This is known as the normal selection strategy.
+func (s *PairSet) Next() Pair {
+	best := 0
+	for k, p := range s.pairs {
+		if s.ord(p.Lcm, s.pairs[best].Lcm) < 0 {
+			best = k
+		}
+	}
+	p := s.pairs[best]
+	s.pairs = append(s.pairs[:best], s.pairs[best+1:]...)
+	return p
+}
+
+// NextMinDegree removes and returns all pairs whose lcm has minimal total
+// degree.
+func (s *PairSet) NextMinDegree() []Pair {
+	minDeg := ^uint(0)
+	for _, p := range s.pairs {
+		if d := totalDeg(p.Lcm); d < minDeg {
+			minDeg = d
+		}
+	}
+	selected := make([]Pair, 0)
+	remaining := s.pairs[:0]
+	for _, p := range s.pairs {
+		if totalDeg(p.Lcm) == minDeg {
+			selected = append(selected, p)
+		} else {
+			remaining = append(remaining, p)
+		}
+	}
+	s.pairs = remaining
+	return selected
+}
+
+// totalDeg returns the sum of the exponents in deg.
+func totalDeg(deg []uint) uint {
+	out := uint(0)
+	for _, d := range deg {
+		out += d
+	}
+	return out
+}
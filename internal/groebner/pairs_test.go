@@ -0,0 +1,79 @@
+package groebner
+
+import (
+	"testing"
+)
+
+// lex compares exponent vectors lexicographically.
+func lex(a, b []uint) int {
+	for i := range a {
+		switch {
+		case a[i] > b[i]:
+			return 1
+		case a[i] < b[i]:
+			return -1
+		}
+	}
+	return 0
+}
+
+func TestPairSet(t *testing.T) {
+	s := NewPairSet(lex)
+
+	// The leading monomials X and Y are coprime
+	s.Update([]uint{1, 0})
+	s.Update([]uint{0, 1})
+	if s.Len() != 0 || s.Stats.Coprime != 1 {
+		t.Errorf("Coprime pair was not discarded (stats = %+v)", s.Stats)
+	}
+
+	// Adding XY makes the pair of X^2Y and XY^2 superfluous, since XY divides
+	// their lcm X^2Y^2 and has smaller lcm with each of them
+	s = NewPairSet(lex)
+	s.Update([]uint{2, 1})
+	s.Update([]uint{1, 2})
+	if s.Len() != 1 {
+		t.Fatalf("Expected 1 pair, but found %d", s.Len())
+	}
+	s.Update([]uint{1, 1})
+	if s.Stats.Chain == 0 {
+		t.Errorf("Chain criterion was not applied (stats = %+v)", s.Stats)
+	}
+
+	// Pairs are selected in increasing order of their lcm
+	prev := []uint{0, 0}
+	for s.Len() > 0 {
+		p := s.Next()
+		if lex(p.Lcm, prev) < 0 {
+			t.Errorf("Pair with lcm %v was selected after %v", p.Lcm, prev)
+		}
+		prev = p.Lcm
+	}
+}
+
+func TestNextMinDegree(t *testing.T) {
+	s := NewPairSet(lex)
+	for _, ld := range [][]uint{{3, 0}, {2, 1}, {1, 1}, {0, 4}} {
+		s.Update(ld)
+	}
+	for s.Len() > 0 {
+		before := s.Len()
+		selected := s.NextMinDegree()
+		if len(selected) == 0 || s.Len() != before-len(selected) {
+			t.Fatalf("NextMinDegree selected %d of %d pairs, leaving %d",
+				len(selected), before, s.Len())
+		}
+		d := totalDeg(selected[0].Lcm)
+		for _, p := range selected {
+			if totalDeg(p.Lcm) != d {
+				t.Errorf("Selected pairs have different degrees")
+			}
+		}
+		for _, p := range s.pairs {
+			if totalDeg(p.Lcm) <= d {
+				t.Errorf("Pair of degree %d was not selected before degree "+
+					"%d", totalDeg(p.Lcm), d)
+			}
+		}
+	}
+}
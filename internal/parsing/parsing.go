@@ -0,0 +1,31 @@
+// Package parsing contains helper functions shared by the parsers of the
+// polynomial packages.
+package parsing
+
+import (
+	"strings"
+)
+
+// TrimParens removes a single pair of enclosing parentheses from s if present.
+// This allows coefficients whose string representation contains parentheses
+// themselves.
+func TrimParens(s string) string {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") {
+		return s
+	}
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth == 0 && i < len(s)-1 {
+			// The first parenthesis is closed before the end of s
+			return s
+		}
+	}
+	return s[1 : len(s)-1]
+}
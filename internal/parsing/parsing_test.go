@@ -0,0 +1,22 @@
+package parsing
+
+import (
+	"testing"
+)
+
+func TestTrimParens(t *testing.T) {
+	tests := map[string]string{
+		"":                "",
+		"3":               "3",
+		" (a^2 + 1) ":     "a^2 + 1",
+		"((a + 1))":       "(a + 1)",
+		"(a + 1)*(a + 2)": "(a + 1)*(a + 2)",
+		"(a + 1":          "(a + 1",
+	}
+	for in, expected := range tests {
+		if out := TrimParens(in); out != expected {
+			t.Errorf("TrimParens(%q) returned %q, but expected %q",
+				in, out, expected)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Package testutil contains helper functions shared by the tests of the code
+// packages.
+package testutil
+
+import (
+	"github.com/ReneBoedker/algobra/finitefield"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// DefineField returns the finite field with card elements. It panics if the
+// field cannot be defined, since this means that the testing code is wrong.
+func DefineField(card uint) ff.Field {
+	field, err := finitefield.Define(card)
+	if err != nil {
+		panic(err)
+	}
+	return field
+}
+
+// RandomVector returns a vector of length n with random entries from field.
+func RandomVector(field ff.Field, n int) []ff.Element {
+	v := make([]ff.Element, n)
+	for i := range v {
+		v[i] = field.RandElement()
+	}
+	return v
+}
+
+// EqualVectors reports whether a and b have the same length and equal entries.
+func EqualVectors(a, b []ff.Element) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,331 @@
+package multivariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// Plus returns the sum of the two polynomials f and g.
+//
+// If f and g are defined over different rings, a new polynomial is returned
+// with an ArithmeticIncompat-error as error status.
+//
+// When f or g has a non-nil error status, its error is wrapped and the same
+// polynomial is returned.
+func (f *Polynomial) Plus(g *Polynomial) *Polynomial {
+	return f.Copy().Add(g)
+}
+
+// Add sets f to the sum of the two polynomials f and g and returns f.
+//
+// If f and g are defined over different rings, a new polynomial is returned
+// with an ArithmeticIncompat-error as error status.
+//
+// When f or g has a non-nil error status, its error is wrapped and the same
+// polynomial is returned.
+func (f *Polynomial) Add(g *Polynomial) *Polynomial {
+	const op = "Adding polynomials"
+
+	if tmp := checkErrAndCompatible(op, f, g); tmp != nil {
+		return tmp
+	}
+
+	for _, t := range g.coefs {
+		f.IncrementCoef(t.deg, t.coef)
+	}
+	return f
+}
+
+// Neg returns the polynomial obtained by scaling f by -1 (modulo the
+// characteristic).
+func (f *Polynomial) Neg() *Polynomial {
+	g := f.baseRing.zeroWithCap(len(f.coefs))
+	for k, t := range f.coefs {
+		g.coefs[k] = term{deg: t.deg, coef: t.coef.Neg()}
+	}
+	return g
+}
+
+// Sub sets f to the difference of the two polynomials f and g and returns f.
+//
+// If f and g are defined over different rings, a new polynomial is returned
+// with an ArithmeticIncompat-error as error status.
+//
+// When f or g has a non-nil error status, its error is wrapped and the same
+// polynomial is returned.
+func (f *Polynomial) Sub(g *Polynomial) *Polynomial {
+	const op = "Subtracting polynomials"
+
+	if tmp := checkErrAndCompatible(op, f, g); tmp != nil {
+		return tmp
+	}
+
+	for _, t := range g.coefs {
+		f.DecrementCoef(t.deg, t.coef)
+	}
+	return f
+}
+
+// Minus returns polynomial difference f-g.
+//
+// If f and g are defined over different rings, a new polynomial is returned
+// with an ArithmeticIncompat-error as error status.
+//
+// When f or g has a non-nil error status, its error is wrapped and the same
+// polynomial is returned.
+func (f *Polynomial) Minus(g *Polynomial) *Polynomial {
+	return f.Copy().Sub(g)
+}
+
+// Internal method. Multiplies the two polynomials f and g, but does not reduce
+// the result according to the specified ring.
+func (f *Polynomial) multNoReduce(g *Polynomial) *Polynomial {
+	const op = "Multiplying polynomials"
+
+	if tmp := checkErrAndCompatible(op, f, g); tmp != nil {
+		return tmp
+	}
+
+	h := f.baseRing.zeroWithCap(len(f.coefs) * len(g.coefs))
+	tmp := f.BaseField().One()
+	for _, tf := range f.coefs {
+		for _, tg := range g.coefs {
+			degSum, err := addDegs(tf.deg, tg.deg)
+			if err != nil {
+				h = f.baseRing.Zero()
+				h.err = errors.Wrap(op, errors.Inherit, err)
+				return h
+			}
+			tmp.Prod(tf.coef, tg.coef)
+			h.IncrementCoef(degSum, tmp)
+		}
+	}
+	return h
+}
+
+// subWithShiftAndScale sets f to the polynomial f-a*X^i*g, where X^i denotes
+// the monomial with degree i. This is done without allocating a new polynomial
+func (f *Polynomial) subWithShiftAndScale(g *Polynomial, i []uint, a ff.Element) {
+	if a.IsZero() {
+		return
+	}
+
+	tmp := f.BaseField().Zero()
+	for _, t := range g.coefs {
+		if t.coef.IsZero() {
+			continue
+		}
+		d := make([]uint, len(i))
+		for j := range d {
+			d[j] = t.deg[j] + i[j]
+		}
+		if a.IsOne() {
+			f.DecrementCoef(d, t.coef)
+		} else {
+			f.DecrementCoef(d, tmp.Prod(a, t.coef))
+		}
+	}
+}
+
+// Times returns the product of the polynomials f and g
+//
+// If f and g are defined over different rings, a new polynomial is returned
+// with an ArithmeticIncompat-error as error status.
+//
+// When f or g has a non-nil error status, its error is wrapped and the same
+// polynomial is returned.
+func (f *Polynomial) Times(g *Polynomial) *Polynomial {
+	h := f.multNoReduce(g)
+	if h.Err() != nil {
+		return h
+	}
+	h.reduce()
+	return h
+}
+
+// Mult sets f to the product of the polynomials f and g and returns f.
+//
+// If f and g are defined over different rings, a new polynomial is returned
+// with an ArithmeticIncompat-error as error status.
+//
+// When f or g has a non-nil error status, its error is wrapped and the same
+// polynomial is returned.
+func (f *Polynomial) Mult(g *Polynomial) *Polynomial {
+	*f = *f.multNoReduce(g)
+	if f.Err() != nil {
+		return f
+	}
+	f.reduce()
+	return f
+}
+
+// Normalize creates a new polynomial obtained by normalizing f. That is,
+// f.Normalize() multiplied by f.Lc() is f.
+//
+// If f is the zero polynomial, a copy of f is returned.
+func (f *Polynomial) Normalize() *Polynomial {
+	if f.IsZero() {
+		return f.Copy()
+	}
+	return f.Scale(f.lcPtr().Inv())
+}
+
+// Scale scales all coefficients of f by the field element c and returns the
+// result as a new polynomial. See also SetScale.
+func (f *Polynomial) Scale(c ff.Element) *Polynomial {
+	if c.IsZero() {
+		return f.baseRing.Zero()
+	}
+
+	g := f.Copy()
+	for _, t := range g.coefs {
+		t.coef.Mult(c)
+	}
+	return g
+}
+
+// SetScale scales all coefficients of f by the field element c and returns
+// f. See also Scale.
+func (f *Polynomial) SetScale(c ff.Element) *Polynomial {
+	if c.IsZero() {
+		*f = *f.baseRing.Zero()
+		return f
+	}
+
+	for _, t := range f.coefs {
+		t.coef.Mult(c)
+	}
+	return f
+}
+
+// Pow raises f to the power of n.
+//
+// If the computation causes the degree of f to overflow, the returned
+// polynomial has an Overflow-error as error status.
+func (f *Polynomial) Pow(n uint) *Polynomial {
+	const op = "Computing polynomial power"
+
+	out := f.baseRing.One()
+	g := f.Copy()
+
+	for n > 0 {
+		if n%2 == 1 {
+			out.Mult(g)
+			if out.Err() != nil {
+				err := out.Err()
+				out = f.baseRing.Zero()
+				out.err = errors.Wrap(op, errors.Inherit, err)
+				return out
+			}
+		}
+		n /= 2
+		if n > 0 {
+			g.Mult(g)
+		}
+	}
+	return out
+}
+
+// QuoRem returns the polynomial quotient and remainder under division by the
+// given list of polynomials.
+func (f *Polynomial) QuoRem(list ...*Polynomial) (q []*Polynomial, r *Polynomial, err error) {
+	return f.quoRemWithIgnore(-1, list...)
+}
+
+func (f *Polynomial) quoRemWithIgnore(
+	ignoreIndex int,
+	list ...*Polynomial,
+) (q []*Polynomial, r *Polynomial, err error) {
+	const op = "Computing polynomial quotient and remainder"
+
+	if tmp := checkErrAndCompatible(op, f, list...); tmp != nil {
+		err = tmp.Err()
+		return
+	}
+
+	r = f.baseRing.Zero()
+	p := f.Copy()
+
+	q = make([]*Polynomial, len(list))
+	for i := range list {
+		q[i] = f.baseRing.Zero()
+	}
+
+	tmp := f.BaseField().Zero()
+outer:
+	for p.IsNonzero() {
+		pLd := p.ld()
+		for i, g := range list {
+			if i == ignoreIndex || g.IsZero() {
+				continue
+			}
+			gLd := g.ld()
+
+			degDiff, ok := subtractDegs(pLd, gLd)
+			if !ok {
+				// Lt of g does not divide Lt of p
+				continue
+			}
+
+			if g.coefPtr(gLd).IsOne() {
+				tmp.Prod(p.coefPtr(pLd), g.coefPtr(gLd))
+			} else {
+				tmp.Prod(p.coefPtr(pLd), g.coefPtr(gLd).Inv())
+			}
+			q[i].IncrementCoef(degDiff, tmp)
+			p.subWithShiftAndScale(g, degDiff, tmp)
+			continue outer
+		}
+		// No generators divide
+		r.IncrementCoef(pLd, p.coefPtr(pLd))
+		p.removeCoef(pLd)
+	}
+	return q, r, nil
+}
+
+// Rem returns the polynomial remainder under division by the given list of
+// polynomials.
+func (f *Polynomial) Rem(list ...*Polynomial) (r *Polynomial, err error) {
+	const op = "Computing polynomial remainder"
+
+	if tmp := checkErrAndCompatible(op, f, list...); tmp != nil {
+		err = tmp.Err()
+		return
+	}
+
+	lds := make([][]uint, len(list))
+	for i, g := range list {
+		lds[i] = g.ld()
+	}
+
+	r = f.baseRing.Zero()
+	p := f.Copy()
+
+	tmp := f.BaseField().Zero()
+outer:
+	for p.IsNonzero() {
+		pLd := p.ld()
+		for i, g := range list {
+			if g.IsZero() {
+				continue
+			}
+			degDiff, ok := subtractDegs(pLd, lds[i])
+			if !ok {
+				// Lt of g does not divide Lt of p
+				continue
+			}
+
+			if c := g.coefPtr(lds[i]); c.IsOne() {
+				tmp.Prod(p.coefPtr(pLd), c)
+			} else {
+				tmp.Prod(p.coefPtr(pLd), c.Inv())
+			}
+			p.subWithShiftAndScale(g, degDiff, tmp)
+			continue outer
+		}
+		// No generators divide
+		r.IncrementCoef(pLd, p.coefPtr(pLd))
+		p.removeCoef(pLd)
+	}
+	return r, nil
+}
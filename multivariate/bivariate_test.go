@@ -0,0 +1,95 @@
+package multivariate_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ReneBoedker/algobra/bivariate"
+	"github.com/ReneBoedker/algobra/finitefield"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/multivariate"
+)
+
+// The bivariate package imports multivariate, so this test is defined in an
+// external test package to avoid an import cycle.
+
+var prg = rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
+
+func TestGroebnerBivariate(t *testing.T) {
+	// For two variables, the results must agree with the bivariate package
+	orders := []struct {
+		multi multivariate.Order
+		bi    bivariate.Order
+	}{
+		{multivariate.Lex(), bivariate.Lex(true)},
+		{multivariate.DegLex(), bivariate.DegLex(true)},
+		{multivariate.DegRevLex(), bivariate.DegRevLex(true)},
+		{multivariate.WDegLex([]uint{2, 3}), bivariate.WDegLex(2, 3, true)},
+	}
+
+	for _, card := range [...]uint{2, 3, 4, 5, 9, 16, 25, 49, 64, 125} {
+		field, err := finitefield.Define(card)
+		if err != nil {
+			t.Fatalf("Failed to define finite field of %d elements", card)
+		}
+		for _, o := range orders {
+			r, err := multivariate.DefRing(field, []string{"X", "Y"}, o.multi)
+			if err != nil {
+				t.Fatalf("Failed to define ring: %v", err)
+			}
+			br := bivariate.DefRing(field, o.bi)
+
+			gens := make([]*multivariate.Polynomial, 3)
+			bGens := make([]*bivariate.Polynomial, 3)
+			for i := range gens {
+				gens[i] = randomPolynomial(r, 4, 3)
+				for gens[i].IsZero() {
+					gens[i] = randomPolynomial(r, 4, 3)
+				}
+				bGens[i] = br.Zero()
+				for _, d := range gens[i].SortedDegrees() {
+					bGens[i].SetCoef([2]uint{d[0], d[1]}, gens[i].Coef(d))
+				}
+			}
+
+			id, _ := r.NewIdeal(gens...)
+			bId, _ := br.NewIdeal(bGens...)
+			gb, bGb := id.GroebnerBasis(), bId.GroebnerBasis()
+			_, _ = gb.ReduceBasis(), bGb.ReduceBasis()
+
+			if len(gb.Generators()) != len(bGb.Generators()) {
+				t.Errorf("Gröbner bases %v and %v differ", gb, bGb)
+				continue
+			}
+		outer:
+			for _, bg := range bGb.Generators() {
+				for _, g := range gb.Generators() {
+					if g.Ld()[0] != bg.Ld()[0] || g.Ld()[1] != bg.Ld()[1] {
+						continue
+					}
+					for _, d := range g.SortedDegrees() {
+						if !g.Coef(d).Equal(bg.Coef([2]uint{d[0], d[1]})) {
+							t.Errorf("Gröbner bases %v and %v differ", gb, bGb)
+						}
+					}
+					continue outer
+				}
+				t.Errorf("Gröbner bases %v and %v differ", gb, bGb)
+			}
+		}
+	}
+}
+
+// randomPolynomial returns a polynomial in two variables with at most nTerms
+// terms of total degree at most maxDeg.
+func randomPolynomial(r *multivariate.QuotientRing, maxDeg uint, nTerms int) *multivariate.Polynomial {
+	degs := make([][]uint, nTerms)
+	coefs := make([]ff.Element, nTerms)
+	for i := range degs {
+		a := uint(prg.Intn(int(maxDeg) + 1))
+		degs[i] = []uint{a, uint(prg.Intn(int(maxDeg-a) + 1))}
+		coefs[i] = r.BaseField().RandElement()
+	}
+	return r.Polynomial(degs, coefs)
+}
@@ -0,0 +1,81 @@
+// Package multivariate implements polynomials in any number of variables over
+// finite fields.
+//
+// # Basic usage
+//
+// To perform computations on multivariate polynomials, first define the finite
+// field and the polynomial ring. The number of variables is given by the
+// variable names. Polynomials can then be constructed in several different
+// ways.
+//
+//	field, _ := finitefield.Define(7)    // Ignoring errors in example
+//	ring, _ := multivariate.DefRing(
+//	    field, []string{"x", "y", "z"}, multivariate.DegRevLex(),
+//	)
+//
+//	// Define f = x^2yz + 6z^3
+//	f := ring.PolynomialFromUnsigned(
+//	    [][]uint{{2, 1, 1}, {0, 0, 3}},
+//	    []uint{1, 6},
+//	)
+//
+//	// The same polynomial can be defined from
+//	g := ring.PolynomialFromSigned(
+//	    [][]uint{{2, 1, 1}, {0, 0, 3}},
+//	    []int{1, -1},
+//	)
+//
+//	fmt.Println(f.Equal(g)) // Prints 'true'
+//
+// In addition to the polynomial definition from slices as above, it is also
+// possible to define polynomials from strings in a natural way by using
+// PolynomialFromString. The order of the variables does not matter, and
+// capitalization is ignored. Using * to indicate multiplication is optional. In
+// addition, the parser supports _Singular-style_ exponents, meaning that
+// '5x2y3' is interpreted as '5x^2y^3'.
+//
+// Variable names can consist of several characters, such as 'x1' or 'alpha'.
+// They can be changed via the method SetVarNames, and the current variable
+// names can be obtained from the VarNames method.
+//
+// # Ideals
+//
+// The package provides support for computations modulo an ideal.
+//
+//	// Let ring be defined as above
+//	f1, _ := ring.PolynomialFromString("x^2-y")
+//	f2, _ := ring.PolynomialFromString("y^2-z")
+//	id, _ := ring.NewIdeal(f1, f2)
+//	qRing, _ := ring.Quotient(id)
+//
+// Once the quotient ring has been defined, polynomials are defined as before.
+// For instance, h, _ := qRing.PolynomialFromString("x^4") sets h to z since the
+// polynomial is automatically reduced modulo the ideal.
+//
+// Internally, this is achieved by transforming the ideal such that its
+// generators form a reduced Gröbner basis. Hence, calling Generators at a
+// later point will not necessarily return the polynomials that were used to
+// define the ideal.
+//
+// # Monomial orderings
+//
+// The following monomial orderings are defined by default. In each of them,
+// the first variable is the largest.
+//   - Lexicographical (Lex)
+//   - Degree lexicographical, also called grlex (DegLex)
+//   - Degree reverse lexicographical, also called grevlex (DegRevLex)
+//   - Weighted degree lexicographical (WDegLex)
+//   - Weighted degree reverse lexicographical (WDegRevLex)
+//   - Block orderings combining two orderings (Block)
+//
+// Additional orderings can be defined by writing a function with signature
+// func(deg1, deg2 []uint) int. For more information, see the documentation
+// for the Order type.
+//
+// # Error handling
+//
+// In order to allow method chaining for arithmetic operations -- such as
+// f.Plus(g).Mult(h.Inv()) -- the methods themselves do not return errors.
+// Instead, potential errors are tied to the resulting polynomial, and the error
+// can be retrieved with the Err-method.
+package multivariate
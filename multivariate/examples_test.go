@@ -0,0 +1,69 @@
+package multivariate_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ReneBoedker/algobra/finitefield"
+	"github.com/ReneBoedker/algobra/multivariate"
+)
+
+func ExampleQuotientRing_PolynomialFromString() {
+	field, _ := finitefield.Define(7)
+	ring, err := multivariate.DefRing(
+		field, []string{"x", "y", "z"}, multivariate.DegRevLex(),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	f, _ := ring.PolynomialFromString("3 - x2*y + 4zx^2y + y^3")
+	fmt.Println(f)
+	// Output:
+	// 4x^2*y*z + 6x^2*y + y^3 + 3
+}
+
+func ExampleQuotientRing_Quotient() {
+	field, _ := finitefield.Define(7)
+	ring, _ := multivariate.DefRing(
+		field, []string{"x", "y", "z"}, multivariate.Lex(),
+	)
+
+	f1, _ := ring.PolynomialFromString("x^2 - y")
+	f2, _ := ring.PolynomialFromString("y^2 - z")
+	id, _ := ring.NewIdeal(f1, f2)
+	qRing, err := ring.Quotient(id)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	h, _ := qRing.PolynomialFromString("x^4 + xy^3")
+	fmt.Println(h)
+	// Output:
+	// x*y*z + z
+}
+
+func ExampleIdeal_GroebnerBasis() {
+	field, _ := finitefield.Define(5)
+	ring, _ := multivariate.DefRing(
+		field, []string{"t", "x", "y"},
+		multivariate.Block(1, multivariate.Lex(), multivariate.DegRevLex()),
+	)
+
+	// Parametrization (t^2, t^3) of a cuspidal cubic
+	f1, _ := ring.PolynomialFromString("x - t^2")
+	f2, _ := ring.PolynomialFromString("y - t^3")
+	id, _ := ring.NewIdeal(f1, f2)
+
+	gb := id.GroebnerBasis()
+	_ = gb.ReduceBasis()
+
+	// The generators without t define the curve
+	for _, g := range gb.Generators() {
+		if g.Ld()[0] == 0 {
+			fmt.Println(g)
+		}
+	}
+	// Output:
+	// x^3 + 4y^2
+}
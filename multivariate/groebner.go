@@ -0,0 +1,303 @@
+package multivariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/internal/groebner"
+)
+
+// totalDeg returns the sum of the exponents in deg.
+func totalDeg(deg []uint) uint {
+	out := uint(0)
+	for _, d := range deg {
+		out += d
+	}
+	return out
+}
+
+// SPolynomial computes the S-polynomial of f and g.
+//
+// It returns an ArithmeticIncompat-error if f and g are defined over different
+// rings.
+func SPolynomial(f, g *Polynomial) (*Polynomial, error) {
+	const op = "Computing S-polynomial"
+
+	if tmp := checkErrAndCompatible(op, f, g); tmp != nil {
+		return nil, tmp.Err()
+	}
+
+	ldf, ldg := f.ld(), g.ld()
+	lcm := groebner.Lcm(ldf, ldg)
+	shiftf, _ := subtractDegs(lcm, ldf) // Always possible since ldf divides lcm
+	shiftg, _ := subtractDegs(lcm, ldg) // (as above)
+
+	// Compute lcm/Lt(f)*f - lcm/Lt(g)*g
+	out := f.baseRing.Zero()
+	out.subWithShiftAndScale(g, shiftg, g.lcPtr().Inv())
+	out.subWithShiftAndScale(f, shiftf, f.lcPtr().Inv().SetNeg())
+	return out, nil
+}
+
+// GroebnerStats contains statistics about a Gröbner basis computation.
+type GroebnerStats struct {
+	Pairs          int // Number of critical pairs formed
+	Coprime        int // Pairs discarded by the product criterion
+	Chain          int // Pairs discarded by the chain criterion
+	Reductions     int // S-polynomials that were reduced
+	ZeroReductions int // S-polynomials that reduced to zero
+}
+
+// Discarded returns the total number of pairs discarded by the criteria.
+func (s *GroebnerStats) Discarded() int {
+	return s.Coprime + s.Chain
+}
+
+// GroebnerBasis computes a Gröbner basis for id. The result is returned as a
+// new ideal object.
+//
+// The generators of the result consist of the generators of id together with
+// the non-zero remainders of the S-polynomials that were reduced. See
+// GroebnerBasisWithStats for details on the algorithm.
+func (id *Ideal) GroebnerBasis() *Ideal {
+	gb, _ := id.GroebnerBasisWithStats()
+	return gb
+}
+
+// groebnerState holds the state of Buchberger's algorithm.
+type groebnerState struct {
+	basis []*Polynomial
+	pairs *groebner.PairSet
+	stats GroebnerStats
+}
+
+// update adds h to the basis and updates the list of critical pairs.
+func (s *groebnerState) update(h *Polynomial) {
+	s.basis = append(s.basis, h)
+	s.pairs.Update(h.Ld())
+}
+
+// buchbergerStep reduces the S-polynomial of the next critical pair and adds
+// the remainder to the basis if it is non-zero.
+func (s *groebnerState) buchbergerStep() {
+	p := s.pairs.Next()
+
+	sPoly, _ := SPolynomial(s.basis[p.I], s.basis[p.J])
+	// Compute the remainder of s. Ignoring error is OK since ideal
+	// generators are compatible
+	r, _ := sPoly.Rem(s.basis...)
+	s.stats.Reductions++
+	if r.IsZero() {
+		s.stats.ZeroReductions++
+		return
+	}
+	s.update(r.Normalize())
+}
+
+// GroebnerBasisWithStats computes a Gröbner basis for id and returns it as a
+// new ideal object. In addition, it returns statistics about the computation.
+//
+// The computation uses Buchberger's algorithm with the normal selection
+// strategy. That is, the critical pair with the smallest least common multiple
+// of the leading monomials is treated first. Pairs are discarded using the
+// product criterion and the chain criterion as described by Gebauer and Möller
+// (see [BW93; Section 5.5]).
+func (id *Ideal) GroebnerBasisWithStats() (*Ideal, *GroebnerStats) {
+	if id.isGroebner == 1 {
+		return id.Copy(), &GroebnerStats{}
+	}
+
+	s := &groebnerState{
+		basis: make([]*Polynomial, 0, len(id.generators)),
+		pairs: groebner.NewPairSet(id.ring.ord),
+	}
+	for _, g := range id.generators {
+		s.update(g.Copy())
+	}
+
+	for s.pairs.Len() > 0 {
+		s.buchbergerStep()
+	}
+	s.stats.Pairs = s.pairs.Stats.Pairs
+	s.stats.Coprime = s.pairs.Stats.Coprime
+	s.stats.Chain = s.pairs.Stats.Chain
+
+	return &Ideal{
+		ring:       id.ring,
+		generators: s.basis,
+		isGroebner: 1,
+		isMinimal:  0,
+		isReduced:  0,
+	}, &s.stats
+}
+
+// IsGroebner returns a boolean describing whether the generators of id form a
+// Gröbner basis.
+func (id *Ideal) IsGroebner() (b bool) {
+	switch id.isGroebner {
+	case 1:
+		return true
+	case -1:
+		return false
+	}
+
+	// Auto-set isGroebner to the return value
+	defer func() {
+		if b {
+			id.isGroebner = 1
+		} else {
+			id.isGroebner = -1
+		}
+	}()
+
+	// Otherwise, check each of the S-polynomials
+	for i, f := range id.generators {
+		for j, g := range id.generators {
+			if j <= i {
+				continue
+			}
+			s, _ := SPolynomial(f, g)
+			// Compute the remainder of s. Ignoring error is OK since ideal
+			// generators are compatible
+			r, _ := s.Rem(id.generators...)
+			if r.IsNonzero() {
+				// Non-zero S-polynomial was found. Not a Gröbner basis
+				return false
+			}
+		}
+	}
+
+	// All S-polynomials reduce to zero. Generators form Gröbner basis
+	return true
+}
+
+// MinimizeBasis transforms the generators of id into a minimal Gröbner basis.
+//
+// If the generators of id do not form a Gröbner basis, the function returns an
+// InputValue-error.
+func (id *Ideal) MinimizeBasis() error {
+	const op = "Minimizing Gröbner basis"
+
+	if !id.IsGroebner() {
+		return errors.New(
+			op, errors.InputValue,
+			"Given ideal is not a Gröbner basis.",
+		)
+	}
+
+	lts := id.leadingTerms()
+
+	for i := 0; i < len(id.generators); {
+		if _, r, _ := lts[i].quoRemWithIgnore(i, lts...); r.IsZero() {
+			// The leading term of the i'th generator is spanned by the others.
+			// Remove this generator from the list.
+			id.generators = append(id.generators[:i], id.generators[i+1:]...)
+			lts = append(lts[:i], lts[i+1:]...)
+		} else {
+			i++
+		}
+	}
+
+	id.isMinimal = 1
+	return nil
+}
+
+// IsMinimal returns a boolean describing whether the generators of id form a
+// minimal Gröbner basis.
+func (id *Ideal) IsMinimal() (b bool) {
+	switch id.isMinimal {
+	case 1:
+		return true
+	case -1:
+		return false
+	}
+	// Auto-set isMinimal to the return value
+	defer func() {
+		if b {
+			id.isMinimal = 1
+		} else {
+			id.isMinimal = -1
+		}
+	}()
+
+	if !id.IsGroebner() {
+		return false
+	}
+
+	lts := id.leadingTerms()
+
+	for i, f := range lts {
+		if _, r, _ := f.quoRemWithIgnore(i, lts...); r.IsZero() {
+			// The leading term of the i'th generator is spanned by the others.
+			// Thus the basis is not minimal
+			return false
+		}
+	}
+
+	return true
+}
+
+// leadingTerms returns the leading terms of the generators of id.
+func (id *Ideal) leadingTerms() []*Polynomial {
+	lts := make([]*Polynomial, len(id.generators))
+	for i := range id.generators {
+		id.generators[i] = id.generators[i].Normalize()
+		lts[i] = id.generators[i].Lt()
+	}
+	return lts
+}
+
+// ReduceBasis transforms the generators of id into a reduced Gröbner basis.
+//
+// If the generators of id do not form a Gröbner basis, the function returns an
+// InputValue-error.
+func (id *Ideal) ReduceBasis() error {
+	const op = "Reducing Gröbner basis"
+
+	if !id.IsGroebner() {
+		return errors.New(
+			op, errors.InputValue,
+			"Given ideal is not a Gröbner basis.",
+		)
+	}
+	if id.isMinimal != 1 {
+		_ = id.MinimizeBasis() // Ignore error since id is Gröbner basis
+	}
+
+	for i := range id.generators {
+		_, id.generators[i], _ = id.generators[i].quoRemWithIgnore(i, id.generators...)
+	}
+
+	id.isReduced = 1
+	return nil
+}
+
+// IsReduced returns a boolean describing whether the generators of id form a
+// reduced Gröbner basis.
+func (id *Ideal) IsReduced() (b bool) {
+	switch id.isReduced {
+	case 1:
+		return true
+	case -1:
+		return false
+	}
+	// Auto-set isReduced to the return value
+	defer func() {
+		if b {
+			id.isReduced = 1
+		} else {
+			id.isReduced = -1
+		}
+	}()
+
+	if !id.IsMinimal() {
+		return false
+	}
+
+	for i := range id.generators {
+		_, r, _ := id.generators[i].quoRemWithIgnore(i, id.generators...)
+		if r.IsNonzero() {
+			return false
+		}
+	}
+
+	return true
+}
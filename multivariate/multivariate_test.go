@@ -0,0 +1,243 @@
+package multivariate
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/internal/groebner"
+)
+
+func defineField(char uint, t *testing.T) ff.Field {
+	field, err := finitefield.Define(char)
+	if err != nil {
+		t.Fatalf("Failed to define finite field of %d elements", char)
+	}
+	return field
+}
+
+func defineRing(field ff.Field, varNames []string, ord Order, t *testing.T) *QuotientRing {
+	r, err := DefRing(field, varNames, ord)
+	if err != nil {
+		t.Fatalf("Failed to define ring with variables %v: %v", varNames, err)
+	}
+	return r
+}
+
+func assertError(t *testing.T, err error, k errors.Kind, desc string, args ...interface{}) {
+	if err == nil {
+		t.Errorf(desc+" returned no error", args...)
+	} else if !errors.Is(k, err) {
+		t.Errorf(desc+" returned an error but not of the correct type", args...)
+	}
+}
+
+func fieldLoop(do func(field ff.Field), minCard ...uint) {
+	for _, card := range [...]uint{2, 3, 4, 5, 9, 16, 25, 49, 64, 125} {
+		if len(minCard) > 0 && card < minCard[0] {
+			continue
+		}
+		field, err := finitefield.Define(card)
+		if err != nil {
+			// Error is in tests, so panic is OK
+			panic(err)
+		}
+
+		do(field)
+	}
+}
+
+func randomPolynomial(r *QuotientRing, maxDeg uint, nTerms int) *Polynomial {
+	degs := make([][]uint, nTerms)
+	coefs := make([]ff.Element, nTerms)
+	for i := range degs {
+		degs[i] = make([]uint, r.NVars())
+		left := int(maxDeg)
+		for _, j := range prg.Perm(r.NVars()) {
+			degs[i][j] = uint(prg.Intn(left + 1))
+			left -= int(degs[i][j])
+		}
+		coefs[i] = r.baseField.RandElement()
+	}
+	return r.Polynomial(degs, coefs)
+}
+
+// equalGenerators determines whether the generators of id1 and id2 coincide up
+// to permutation.
+func equalGenerators(id1, id2 *Ideal) bool {
+	if len(id1.generators) != len(id2.generators) {
+		return false
+	}
+outer:
+	for _, g := range id1.generators {
+		for _, h := range id2.generators {
+			if g.Equal(h) {
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func TestReduce(t *testing.T) {
+	field := defineField(3, t)
+	r := defineRing(field, []string{"x", "y", "z"}, WDegLex([]uint{1, 2, 3}), t)
+	mod := r.PolynomialFromUnsigned([][]uint{{9, 0, 0}, {1, 0, 0}}, []uint{1, 2})
+	id, err := r.NewIdeal(mod)
+	if err != nil {
+		t.Fatalf("Failed to construct ideal. Error message %q", err.Error())
+	}
+	qr, err := r.Quotient(id)
+	if err != nil {
+		t.Fatalf("Failed to construct quotient ring")
+	}
+	f := qr.PolynomialFromUnsigned([][]uint{{12, 3, 1}}, []uint{1})
+
+	if ld := f.Ld(); !groebner.Equal(ld, []uint{4, 3, 1}) {
+		t.Errorf("Reduce failed: Got %v", ld)
+	}
+}
+
+func TestGroebner(t *testing.T) {
+	// Example from Cox, Little and O'Shea with the additional variable z
+	field := defineField(7, t)
+	r := defineRing(field, []string{"x", "y", "z"}, DegLex(), t)
+	f1, _ := r.PolynomialFromString("x^3 - 2xy")
+	f2, _ := r.PolynomialFromString("x^2y - 2y^2 + x")
+	id, err := r.NewIdeal(f1, f2)
+	if err != nil {
+		t.Fatalf("Failed to construct ideal: %v", err)
+	}
+
+	gb := id.GroebnerBasis()
+	if !gb.IsGroebner() {
+		t.Errorf("GroebnerBasis returned %v, which is not a Gröbner basis", gb)
+	}
+	if err := gb.ReduceBasis(); err != nil {
+		t.Fatalf("ReduceBasis failed: %v", err)
+	}
+
+	g1, _ := r.PolynomialFromString("x^2")
+	g2, _ := r.PolynomialFromString("xy")
+	g3, _ := r.PolynomialFromString("y^2 + 3x")
+	expected, _ := r.NewIdeal(g1, g2, g3)
+	if !equalGenerators(gb, expected) {
+		t.Errorf("Reduced Gröbner basis is %v, but expected %v", gb, expected)
+	}
+	if !gb.IsReduced() {
+		t.Errorf("IsReduced returned false for %v", gb)
+	}
+}
+
+func TestGroebnerElimination(t *testing.T) {
+	// The twisted cubic (t, t^2, t^3). Eliminating t gives the defining
+	// equations in x, y and z
+	field := defineField(5, t)
+	r := defineRing(
+		field, []string{"t", "x", "y", "z"},
+		Block(1, Lex(), DegRevLex()), t,
+	)
+	f1, _ := r.PolynomialFromString("x - t")
+	f2, _ := r.PolynomialFromString("y - t^2")
+	f3, _ := r.PolynomialFromString("z - t^3")
+	id, _ := r.NewIdeal(f1, f2, f3)
+
+	gb := id.GroebnerBasis()
+	_ = gb.ReduceBasis()
+
+	for _, s := range []string{"x^2 - y", "xy - z", "y^2 - xz"} {
+		f, _ := r.PolynomialFromString(s)
+		if _, rem, _ := f.QuoRem(gb.generators...); rem.IsNonzero() {
+			t.Errorf("%v is not in the ideal (remainder %v)", f, rem)
+		}
+		found := false
+		for _, g := range gb.generators {
+			found = found || g.Equal(f)
+		}
+		if !found {
+			t.Errorf("%v is not in the reduced Gröbner basis %v", f, gb)
+		}
+	}
+}
+
+func TestGroebnerCriteria(t *testing.T) {
+	field := defineField(3, t)
+	r := defineRing(field, []string{"a", "b", "c"}, DegRevLex(), t)
+
+	// Cyclic 3-roots
+	f1, _ := r.PolynomialFromString("a + b + c")
+	f2, _ := r.PolynomialFromString("ab + bc + ca")
+	f3, _ := r.PolynomialFromString("abc - 1")
+	id, _ := r.NewIdeal(f1, f2, f3)
+
+	gb, stats := id.GroebnerBasisWithStats()
+	if !gb.IsGroebner() {
+		t.Errorf("GroebnerBasisWithStats returned %v, which is not a Gröbner basis", gb)
+	}
+	if stats.Pairs != stats.Discarded()+stats.Reductions {
+		t.Errorf("Inconsistent statistics %+v", stats)
+	}
+
+	for rep := 0; rep < 5; rep++ {
+		gens := make([]*Polynomial, 3)
+		for i := range gens {
+			gens[i] = randomPolynomial(r, 3, 3)
+			for gens[i].IsZero() {
+				gens[i] = randomPolynomial(r, 3, 3)
+			}
+		}
+		id, _ := r.NewIdeal(gens...)
+		gb := id.GroebnerBasis()
+		_ = gb.ReduceBasis()
+		gb.isGroebner = 0
+		if !gb.IsGroebner() {
+			t.Errorf("GroebnerBasis of %v returned %v, which is not a Gröbner basis", id, gb)
+		}
+	}
+}
+
+func TestQuotientErrors(t *testing.T) {
+	field := defineField(7, t)
+	r := defineRing(field, []string{"x", "y", "z"}, Lex(), t)
+	f, _ := r.PolynomialFromString("x^2")
+	id, _ := r.NewIdeal(f)
+	qr, err := r.Quotient(id)
+	if err != nil {
+		t.Fatalf("Quotient failed: %v", err)
+	}
+
+	_, err = qr.Quotient(id)
+	assertError(t, err, errors.InputValue, "Quotient of quotient ring")
+
+	r2 := defineRing(field, []string{"x", "y", "z"}, Lex(), t)
+	_, err = r2.Quotient(id)
+	assertError(t, err, errors.InputIncompatible, "Quotient by ideal of other ring")
+
+	_, err = r2.NewIdeal(f)
+	assertError(t, err, errors.InputIncompatible, "Ideal with generators of other ring")
+
+	_, err = r.NewIdeal(r.Zero())
+	assertError(t, err, errors.InputValue, "Ideal with zero generators")
+}
+
+func TestBadVarNames(t *testing.T) {
+	field := defineField(7, t)
+	for _, names := range [][]string{
+		{},
+		{"x", "  "},
+		{"x", "X"},
+		{"x", "y", " x "},
+		{"x+y"},
+		{"2x"},
+		{"x^"},
+	} {
+		_, err := DefRing(field, names, Lex())
+		assertError(t, err, errors.InputValue, "DefRing with variable names %q", names)
+	}
+
+	r := defineRing(field, []string{"x", "y"}, Lex(), t)
+	err := r.SetVarNames([]string{"x", "y", "z"})
+	assertError(t, err, errors.InputValue, "SetVarNames with too many names")
+}
@@ -0,0 +1,139 @@
+package multivariate
+
+// Order denotes an order function.
+//
+// Return value is meant to be interpreted in the following way:
+// *	-1: deg1 < deg2
+// *	 0: deg1 == deg2
+// *	 1: deg1 > deg2
+// By defining functions of type Order, additional monomial orders can be used.
+//
+// The inputs always have one entry for each variable of the ring. Entry 0
+// corresponds to the first variable name given when defining the ring.
+type Order func(deg1, deg2 []uint) int
+
+// Lex returns the lexicographical ordering, in which the first variable is the
+// largest. That is, X_0 > X_1 > ... > X_(n-1).
+func Lex() Order {
+	return func(deg1, deg2 []uint) int {
+		for i := range deg1 {
+			switch {
+			case deg1[i] > deg2[i]:
+				return 1
+			case deg1[i] < deg2[i]:
+				return -1
+			}
+		}
+		return 0
+	}
+}
+
+// revLex is the tiebreak used for the reverse lexicographical orderings. The
+// degrees are compared starting from the last variable, and the degree with the
+// smaller exponent is considered to be the larger one.
+func revLex(deg1, deg2 []uint) int {
+	for i := len(deg1) - 1; i >= 0; i-- {
+		switch {
+		case deg1[i] < deg2[i]:
+			return 1
+		case deg1[i] > deg2[i]:
+			return -1
+		}
+	}
+	return 0
+}
+
+// weightedDeg returns the weighted degree of deg. Variables without a weight
+// have weight 1.
+func weightedDeg(deg, weights []uint) uint {
+	out := uint(0)
+	for i, d := range deg {
+		if i < len(weights) {
+			out += weights[i] * d
+		} else {
+			out += d
+		}
+	}
+	return out
+}
+
+// degCompare is an internal function used for the (weighted) degree (reverse)
+// lexicographical orderings.
+func degCompare(weights []uint, tiebreak Order) Order {
+	weights = append([]uint{}, weights...)
+	return func(deg1, deg2 []uint) int {
+		w1, w2 := weightedDeg(deg1, weights), weightedDeg(deg2, weights)
+		switch {
+		case w1 > w2:
+			return 1
+		case w1 < w2:
+			return -1
+		}
+		return tiebreak(deg1, deg2)
+	}
+}
+
+// WDegLex returns the weighted degree lexicographical ordering.
+//
+// The resulting order will first compare the weighted degree using the given
+// weights, where weights[i] is the weight of the i'th variable. Variables
+// without a weight are given weight 1. Any ties are broken using the
+// lexicographical ordering.
+//
+// The weights must be positive for the result to be a monomial order.
+func WDegLex(weights []uint) Order {
+	return degCompare(weights, Lex())
+}
+
+// WDegRevLex returns the weighted degree reverse lexicographical ordering.
+//
+// The resulting order will first compare the weighted degree using the given
+// weights, where weights[i] is the weight of the i'th variable. Variables
+// without a weight are given weight 1. Any ties are broken by considering the
+// exponent of the last variable, then the second to last, and so on. The
+// degree with the smaller exponent is considered to be the larger degree.
+//
+// The weights must be positive for the result to be a monomial order.
+func WDegRevLex(weights []uint) Order {
+	return degCompare(weights, revLex)
+}
+
+// DegLex returns the total degree lexicographical ordering, which is also known
+// as grlex.
+//
+// The resulting order will first compare the total degree and then break any
+// ties using the lexicographical ordering.
+func DegLex() Order {
+	return WDegLex(nil)
+}
+
+// DegRevLex returns the total degree reverse lexicographical ordering, which is
+// also known as grevlex.
+//
+// The resulting order will first compare the total degree. Any ties are broken
+// by considering the exponent of the last variable, then the second to last,
+// and so on. The degree with the smaller exponent is considered to be the
+// larger degree.
+func DegRevLex() Order {
+	return WDegRevLex(nil)
+}
+
+// Block returns a block ordering (also called a product ordering). The first n
+// variables are compared using the order first. If they are equal, the
+// remaining variables are compared using the order second.
+//
+// Each of the orders first and second receives only the exponents of its own
+// block. Hence, block orderings can be nested to obtain orderings with more
+// than two blocks. Choosing Lex for each block gives the lexicographical
+// ordering.
+//
+// Block orderings are useful for elimination, since the first n variables are
+// eliminated when computing a Gröbner basis.
+func Block(n int, first, second Order) Order {
+	return func(deg1, deg2 []uint) int {
+		if out := first(deg1[:n], deg2[:n]); out != 0 {
+			return out
+		}
+		return second(deg1[n:], deg2[n:])
+	}
+}
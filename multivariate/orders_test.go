@@ -0,0 +1,88 @@
+package multivariate
+
+import (
+	"testing"
+)
+
+func TestOrders(t *testing.T) {
+	degrees := [][2][]uint{
+		{{1, 1, 1}, {1, 1, 1}},
+		{{2, 1, 0}, {1, 1, 1}},
+		{{1, 0, 2}, {0, 2, 1}},
+		{{0, 0, 4}, {1, 0, 0}},
+		{{1, 2, 0}, {2, 0, 1}},
+		{{0, 3, 0}, {1, 1, 1}},
+	}
+	orders := []Order{
+		Lex(),
+		DegLex(),
+		DegRevLex(),
+		WDegLex([]uint{3, 2, 1}),
+		WDegRevLex([]uint{1, 1, 5}),
+		Block(1, Lex(), DegRevLex()),
+		Block(2, DegRevLex(), Lex()),
+	}
+	ordStr := []string{
+		"Lex",
+		"DegLex",
+		"DegRevLex",
+		"WDegLex(3,2,1)",
+		"WDegRevLex(1,1,5)",
+		"Block(1, Lex, DegRevLex)",
+		"Block(2, DegRevLex, Lex)",
+	}
+	expectedOrd := [][]int{
+		{0, 0, 0, 0, 0, 0, 0},
+		{1, 1, 1, 1, -1, 1, 1},
+		{1, 1, -1, 1, 1, 1, -1},
+		{-1, 1, 1, 1, 1, -1, -1},
+		{-1, -1, 1, -1, -1, -1, 1},
+		{-1, -1, 1, -1, -1, -1, 1},
+	}
+
+	for i, d := range degrees {
+		for j, o := range orders {
+			if res := o(d[0], d[1]); res != expectedOrd[i][j] {
+				t.Errorf(
+					"Wrong ordering of %v and %v using %s (got %d, expected %d)",
+					d[0], d[1], ordStr[j], res, expectedOrd[i][j],
+				)
+			}
+		}
+	}
+}
+
+func TestOrdersMultiplicative(t *testing.T) {
+	// A monomial order must be preserved under multiplication by monomials
+	orders := []Order{
+		Lex(),
+		DegLex(),
+		DegRevLex(),
+		WDegLex([]uint{3, 2, 1, 1}),
+		WDegRevLex([]uint{1, 4, 1, 2}),
+		Block(2, DegRevLex(), DegLex()),
+	}
+	degrees := [][]uint{
+		{0, 0, 0, 0}, {1, 0, 0, 0}, {0, 1, 2, 0}, {3, 0, 0, 1},
+		{1, 1, 1, 1}, {0, 0, 0, 5}, {2, 2, 0, 0}, {0, 1, 0, 3},
+	}
+	for k, o := range orders {
+		for _, a := range degrees {
+			if o(a, degrees[0]) < 0 {
+				t.Errorf("Order %d: %v is smaller than 1", k, a)
+			}
+			for _, b := range degrees {
+				for _, c := range degrees {
+					ac, _ := addDegs(a, c)
+					bc, _ := addDegs(b, c)
+					if o(a, b) != o(ac, bc) {
+						t.Errorf(
+							"Order %d: comparing %v and %v differs from "+
+								"comparing %v and %v", k, a, b, ac, bc,
+						)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package multivariate
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/internal/parsing"
+)
+
+// parser holds the information needed to parse polynomials over a given ring.
+type parser struct {
+	qr       *QuotientRing
+	coef     *regexp.Regexp
+	varNames []string // Lower case variable names
+}
+
+func newParser(op errors.Op, qr *QuotientRing) (*parser, error) {
+	// Match a coefficient at the beginning of a term
+	pattern, err := regexp.Compile(`^(?:` + qr.baseField.RegexElement(true) + `)`)
+	if err != nil {
+		return nil, errors.New(
+			op, errors.Internal,
+			"Failed to compile regular expression for elements of %v",
+			qr.baseField,
+		)
+	}
+	pattern.Longest()
+
+	varNames := make([]string, len(qr.varNames))
+	for i, v := range qr.varNames {
+		varNames[i] = strings.ToLower(v)
+	}
+	return &parser{qr: qr, coef: pattern, varNames: varNames}, nil
+}
+
+// splitTerms splits s into terms at each sign that is not enclosed in
+// parentheses. The sign of each term is returned separately.
+func splitTerms(op errors.Op, s string) (terms []string, negative []bool, err error) {
+	terms = make([]string, 0)
+	negative = make([]bool, 0)
+
+	depth, start, neg := 0, 0, false
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, nil, errors.New(
+					op, errors.Parsing,
+					"Unbalanced parentheses in %q", s,
+				)
+			}
+		case '+', '-':
+			if depth > 0 {
+				continue
+			}
+			if t := strings.TrimSpace(s[start:i]); t != "" || len(terms) > 0 || neg {
+				terms = append(terms, t)
+				negative = append(negative, neg)
+			}
+			start, neg = i+1, (r == '-')
+		}
+	}
+	if depth != 0 {
+		return nil, nil, errors.New(
+			op, errors.Parsing,
+			"Unbalanced parentheses in %q", s,
+		)
+	}
+	terms = append(terms, strings.TrimSpace(s[start:]))
+	negative = append(negative, neg)
+
+	for _, t := range terms {
+		if t == "" {
+			return nil, nil, errors.New(
+				op, errors.Parsing,
+				"Cannot parse %q; found an empty term", s,
+			)
+		}
+	}
+	return terms, negative, nil
+}
+
+// parseMonomial parses s as a product of variables, each raised to an optional
+// power. It returns false if this is not possible.
+func (p *parser) parseMonomial(s string) (deg []uint, ok bool) {
+	deg = make([]uint, len(p.varNames))
+	s = strings.ToLower(s)
+
+	for {
+		s = strings.TrimLeft(s, " \t\n")
+		if s == "" {
+			return deg, true
+		}
+		if s[0] == '*' {
+			s = s[1:]
+			continue
+		}
+
+		// Find the longest variable name that is a prefix of s
+		v := -1
+		for i, name := range p.varNames {
+			if strings.HasPrefix(s, name) && (v < 0 || len(name) > len(p.varNames[v])) {
+				v = i
+			}
+		}
+		if v < 0 {
+			return nil, false
+		}
+		s = strings.TrimLeft(s[len(p.varNames[v]):], " \t\n")
+
+		// Read the optional exponent
+		caret := strings.HasPrefix(s, "^")
+		if caret {
+			s = strings.TrimLeft(s[1:], " \t\n")
+		}
+		end := 0
+		for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+			end++
+		}
+		if caret && end == 0 {
+			return nil, false
+		}
+		e, err := parseExponent(s[:end])
+		if err != nil {
+			return nil, false
+		}
+		deg[v] += e
+		s = s[end:]
+	}
+}
+
+// parseTerm parses a single term without sign.
+func (p *parser) parseTerm(op errors.Op, s string) (deg []uint, coef ff.Element, err error) {
+	// Try to read a coefficient followed by a monomial. If this fails, the term
+	// may consist of variables only, since variable names can resemble
+	// coefficients.
+	if loc := p.coef.FindStringIndex(s); loc != nil && loc[1] > 0 {
+		if deg, ok := p.parseMonomial(s[loc[1]:]); ok {
+			coef, err = p.qr.baseField.ElementFromString(parsing.TrimParens(s[:loc[1]]))
+			if err != nil {
+				return nil, nil, errors.Wrap(op, errors.Conversion, err)
+			}
+			return deg, coef, nil
+		}
+	}
+	if deg, ok := p.parseMonomial(s); ok {
+		return deg, p.qr.baseField.One(), nil
+	}
+	return nil, nil, errors.New(
+		op, errors.Parsing,
+		"Cannot parse term %q", s,
+	)
+}
+
+func parseExponent(s string) (uint, error) {
+	if s == "" {
+		return 1, nil
+	}
+	tmp, err := strconv.ParseUint(s, 10, 0)
+	return uint(tmp), err
+}
+
+// polynomialFromString parses s as a polynomial over qr. The result is not
+// reduced.
+func polynomialFromString(s string, qr *QuotientRing) (*Polynomial, error) {
+	const op = "Parsing polynomial from string"
+
+	p, err := newParser(op, qr)
+	if err != nil {
+		return nil, err
+	}
+
+	terms, negative, err := splitTerms(op, s)
+	if err != nil {
+		return nil, err
+	}
+
+	out := qr.Zero()
+	for i, t := range terms {
+		deg, coef, err := p.parseTerm(op, t)
+		if err != nil {
+			return nil, err
+		}
+		if negative[i] {
+			coef.SetNeg()
+		}
+		out.IncrementCoef(deg, coef)
+	}
+	return out, nil
+}
@@ -0,0 +1,117 @@
+package multivariate
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+func TestParsingWellFormed(t *testing.T) {
+	field := defineField(7, t)
+	ring := defineRing(field, []string{"x", "y", "z"}, Lex(), t)
+	testStrings := []string{
+		"2x^3y^2z+x^3-2y+2",
+		"2 x3y2z + x^3 - 2Y+ 2",
+		"2 X^3 Y^2 Z + x3 - 2 Y + 2",
+		"z*x^3Y^2 + x^3*y^2*z +X3    - 2  y  +2",
+		"+2 x^2*z*x*y*y + x^3 - y - y + 2",
+	}
+	testPolys := make([]*Polynomial, len(testStrings), len(testStrings)+1)
+	for i, s := range testStrings {
+		var err error
+		testPolys[i], err = ring.PolynomialFromString(s)
+		if err != nil {
+			t.Errorf(
+				"Failed to parse polynomial %s. Received error %v", s, err,
+			)
+		}
+	}
+	testPolys = append(testPolys, ring.PolynomialFromUnsigned(
+		[][]uint{{3, 2, 1}, {3, 0, 0}, {0, 1, 0}, {0, 0, 0}},
+		[]uint{2, 1, 5, 2},
+	))
+	for i, f := range testPolys {
+		for j := i + 1; j < len(testPolys); j++ {
+			if !f.Equal(testPolys[j]) {
+				t.Errorf(
+					"The two polynomials f_%d=%v and f_%d=%v are not equal (but they should be)",
+					i, f, j, testPolys[j],
+				)
+			}
+		}
+	}
+}
+
+func TestParsingLongNames(t *testing.T) {
+	field := defineField(4, t)
+	ring := defineRing(field, []string{"x", "xy", "alpha"}, DegRevLex(), t)
+
+	a, err := field.ElementFromString("a")
+	if err != nil {
+		t.Fatalf("Failed to parse field element: %v", err)
+	}
+	f, err := ring.PolynomialFromString("(a+1)xyx - alpha^2x + a*xy2 + x^3 + 1")
+	if err != nil {
+		t.Fatalf("Failed to parse polynomial: %v", err)
+	}
+	expected := ring.Polynomial(
+		[][]uint{{1, 1, 0}, {1, 0, 2}, {0, 2, 0}, {3, 0, 0}, {0, 0, 0}},
+		[]ff.Element{
+			a.Plus(field.One()),
+			field.One(),
+			a,
+			field.One(),
+			field.One(),
+		},
+	)
+	if !f.Equal(expected) {
+		t.Errorf("Parsing gave %v, but expected %v", f, expected)
+	}
+}
+
+func TestParsingIllFormed(t *testing.T) {
+	field := defineField(7, t)
+	ring := defineRing(field, []string{"x", "y", "z"}, Lex(), t)
+
+	testStrings := []string{
+		"2^2 x",
+		"x^2-+y^3",
+		"x^^4y^5",
+		"a^3y^4",
+		"2x^2 4y",
+		"x^2 + (y",
+		"x + y)",
+		"x + ",
+		"",
+	}
+
+	for _, s := range testStrings {
+		f, err := ring.PolynomialFromString(s)
+		if err == nil {
+			t.Errorf(
+				"Parsing %q returned polynomial %v instead of an error", s, f,
+			)
+		} else if !errors.Is(errors.Parsing, err) {
+			t.Errorf(
+				"Expected errors.Parsing while parsing %q, but received error %q",
+				s, err.Error(),
+			)
+		}
+	}
+}
+
+func TestParsingRoundTrip(t *testing.T) {
+	fieldLoop(func(field ff.Field) {
+		ring := defineRing(field, []string{"u", "v", "w", "t"}, DegLex(), t)
+		for rep := 0; rep < 10; rep++ {
+			f := randomPolynomial(ring, 4, 6)
+			g, err := ring.PolynomialFromString(f.String())
+			if err != nil {
+				t.Errorf("Parsing %v failed: %v", f, err)
+			} else if !f.Equal(g) {
+				t.Errorf("Parsing %v over %v gave %v", f, field, g)
+			}
+		}
+	})
+}
@@ -0,0 +1,595 @@
+package multivariate
+
+import (
+	"encoding/binary"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/internal/groebner"
+)
+
+// term is a single term of a polynomial. The degree slice is never modified
+// after the term has been created.
+type term struct {
+	deg  []uint
+	coef ff.Element
+}
+
+// Polynomial implements multivariate polynomials.
+type Polynomial struct {
+	baseRing *QuotientRing
+	coefs    map[string]term
+	err      error
+}
+
+// degKey returns the map key corresponding to the degree deg.
+func degKey(deg []uint) string {
+	b := make([]byte, 8*len(deg))
+	for i, d := range deg {
+		binary.LittleEndian.PutUint64(b[8*i:], uint64(d))
+	}
+	return string(b)
+}
+
+// BaseField returns the field over which the coefficients of f are defined.
+func (f *Polynomial) BaseField() ff.Field {
+	return f.baseRing.baseField
+}
+
+// Err returns the error status of f.
+func (f *Polynomial) Err() error {
+	return f.err
+}
+
+// checkDeg returns an InputValue-error if deg does not contain exactly one
+// exponent for each variable of r.
+func (r *QuotientRing) checkDeg(op errors.Op, deg []uint) error {
+	if len(deg) != len(r.varNames) {
+		return errors.New(
+			op, errors.InputValue,
+			"Degree %v does not match the %d variables of the ring",
+			deg, len(r.varNames),
+		)
+	}
+	return nil
+}
+
+// Zero returns a zero polynomial over the specified ring.
+func (r *QuotientRing) Zero() *Polynomial {
+	return &Polynomial{
+		baseRing: r,
+		coefs:    make(map[string]term),
+	}
+}
+
+// zeroWithCap returns a zero polynomial over the specified ring where the
+// underlying map has given capacity.
+func (r *QuotientRing) zeroWithCap(cap int) *Polynomial {
+	return &Polynomial{
+		baseRing: r,
+		coefs:    make(map[string]term, cap),
+	}
+}
+
+// One returns the constant polynomial 1 over the specified ring.
+func (r *QuotientRing) One() *Polynomial {
+	out := r.Zero()
+	out.SetCoefPtr(make([]uint, r.NVars()), r.baseField.One())
+	out.reduce()
+	return out
+}
+
+// Var returns the polynomial consisting of the i'th variable of r. The
+// variables are numbered from 0.
+//
+// If i is not the index of a variable, the returned polynomial has an
+// InputValue-error as error status.
+func (r *QuotientRing) Var(i int) *Polynomial {
+	const op = "Defining variable"
+
+	out := r.Zero()
+	if i < 0 || i >= r.NVars() {
+		out.err = errors.New(
+			op, errors.InputValue,
+			"Ring has no variable with index %d", i,
+		)
+		return out
+	}
+	deg := make([]uint, r.NVars())
+	deg[i] = 1
+	out.SetCoefPtr(deg, r.baseField.One())
+	out.reduce()
+	return out
+}
+
+// polynomialFromFunc defines a polynomial with the given degrees, where the
+// coefficient of degs[i] is given by coef(i).
+func (r *QuotientRing) polynomialFromFunc(op errors.Op, degs [][]uint, nCoefs int, coef func(i int) ff.Element) *Polynomial {
+	out := r.zeroWithCap(len(degs))
+	if len(degs) != nCoefs {
+		out.err = errors.New(
+			op, errors.InputValue,
+			"Received %d degrees, but %d coefficients", len(degs), nCoefs,
+		)
+		return out
+	}
+	for i, d := range degs {
+		if err := r.checkDeg(op, d); err != nil {
+			out.err = err
+			return out
+		}
+		out.IncrementCoef(d, coef(i))
+	}
+	out.reduce()
+	return out
+}
+
+// Polynomial defines a new polynomial such that the coefficient of the monomial
+// with degree degs[i] is coefs[i]. If a degree is given several times, the
+// coefficients are added.
+//
+// If degs and coefs have different lengths, or if one of the degrees does not
+// contain an exponent for each variable, the returned polynomial has an
+// InputValue-error as error status.
+func (r *QuotientRing) Polynomial(degs [][]uint, coefs []ff.Element) *Polynomial {
+	const op = "Defining polynomial"
+	return r.polynomialFromFunc(op, degs, len(coefs), func(i int) ff.Element {
+		return coefs[i]
+	})
+}
+
+// PolynomialFromUnsigned defines a new polynomial with the given coefficients.
+// See Polynomial for a description of the inputs.
+func (r *QuotientRing) PolynomialFromUnsigned(degs [][]uint, coefs []uint) *Polynomial {
+	const op = "Defining polynomial"
+	return r.polynomialFromFunc(op, degs, len(coefs), func(i int) ff.Element {
+		return r.baseField.ElementFromUnsigned(coefs[i])
+	})
+}
+
+// PolynomialFromSigned defines a new polynomial with the given coefficients.
+// See Polynomial for a description of the inputs.
+func (r *QuotientRing) PolynomialFromSigned(degs [][]uint, coefs []int) *Polynomial {
+	const op = "Defining polynomial"
+	return r.polynomialFromFunc(op, degs, len(coefs), func(i int) ff.Element {
+		return r.baseField.ElementFromSigned(coefs[i])
+	})
+}
+
+// PolynomialFromString defines a polynomial by parsing s.
+//
+// The string s must use the variable names specified by r, but capitalization
+// is ignored. Multiplication symbol '*' is allowed, but not necessary.
+// Additionally, Singular-style exponents are allowed, meaning that "X2Y3" is
+// interpreted as "X^2Y^3". The variables in a term may appear in any order, and
+// a variable may appear several times.
+//
+// When a variable name is a prefix of another, the longest name is used. For
+// instance, if the variables are "x" and "xy", the string "xyx" is interpreted
+// as "xy*x".
+//
+// If the string cannot be parsed, the function returns the zero polynomial and
+// a Parsing-error.
+func (r *QuotientRing) PolynomialFromString(s string) (*Polynomial, error) {
+	f, err := polynomialFromString(s, r)
+	if err != nil {
+		return r.Zero(), err
+	}
+	f.reduce()
+	return f, nil
+}
+
+// addDegs computes the component-wise sum of deg1 and deg2
+//
+// If any component overflows the size of the uint type, the function returns
+// an Overflow-error
+func addDegs(deg1, deg2 []uint) (sum []uint, err error) {
+	const op = "Adding degrees"
+	sum = make([]uint, len(deg1))
+	for i := range deg1 {
+		sum[i] = deg1[i] + deg2[i]
+		if sum[i] < deg1[i] {
+			err = errors.New(
+				op, errors.Overflow,
+				"%v + %v overflows uint", deg1, deg2,
+			)
+		}
+	}
+	return
+}
+
+// subtractDegs computes the component-wise difference deg1 and deg2
+//
+// The return value ok indicates whether each component of deg1 is at least as
+// large as the corresponding component of deg2.
+func subtractDegs(deg1, deg2 []uint) (deg []uint, ok bool) {
+	if !groebner.Divides(deg2, deg1) {
+		return nil, false
+	}
+	deg = make([]uint, len(deg1))
+	for i := range deg1 {
+		deg[i] = deg1[i] - deg2[i]
+	}
+	return deg, true
+}
+
+// Coef returns the coefficient of the monomial with degree specified by the
+// input. The return value is a finite field element.
+//
+// If deg does not contain an exponent for each variable, the zero element is
+// returned.
+func (f *Polynomial) Coef(deg []uint) ff.Element {
+	if t, ok := f.coefs[degKey(deg)]; ok {
+		return t.coef.Copy()
+	}
+	return f.BaseField().Zero()
+}
+
+// coefPtr returns a pointer to the coefficient of the monomial with degree
+// specified by the input. The return value is nil if the coefficient does not
+// exist.
+func (f *Polynomial) coefPtr(deg []uint) ff.Element {
+	if t, ok := f.coefs[degKey(deg)]; ok {
+		return t.coef
+	}
+	return nil
+}
+
+// SetCoef sets the coefficient of the monomial with degree deg in f to val by
+// copying. See also SetCoefPtr.
+//
+// If deg does not contain an exponent for each variable, f is left unchanged
+// and its error status is set to an InputValue-error.
+func (f *Polynomial) SetCoef(deg []uint, val ff.Element) {
+	f.SetCoefPtr(deg, val.Copy())
+}
+
+// SetCoefPtr sets the coefficient of the monomial with degree deg in f to ptr
+// as a pointer. To set coefficient as a value, use SetCoef instead.
+//
+// If deg does not contain an exponent for each variable, f is left unchanged
+// and its error status is set to an InputValue-error.
+func (f *Polynomial) SetCoefPtr(deg []uint, ptr ff.Element) {
+	const op = "Setting coefficient"
+	if err := f.baseRing.checkDeg(op, deg); err != nil {
+		f.err = err
+		return
+	}
+
+	k := degKey(deg)
+	if ptr.IsZero() {
+		delete(f.coefs, k)
+	} else {
+		f.coefs[k] = term{deg: append([]uint{}, deg...), coef: ptr}
+	}
+}
+
+// EmbedIn embeds f in the ring r if possible. The input reduce determines if f
+// is reduced in the new ring.
+//
+// An InputIncompatible-error is returned if r and the polynomial ring of f are
+// not compatible.
+func (f *Polynomial) EmbedIn(r *QuotientRing, reduce bool) error {
+	const op = "Embedding polynomial in ring"
+
+	if f.baseRing.ring != r.ring {
+		return errors.New(
+			op, errors.InputIncompatible,
+			"Cannot embed polynomial over %v in %v", f.baseRing, r,
+		)
+	}
+
+	f.baseRing = r
+	if reduce {
+		f.reduce()
+	}
+	return nil
+}
+
+// IncrementCoef increments the coefficient of the monomial with degree deg in f
+// by val.
+//
+// If deg does not contain an exponent for each variable, f is left unchanged
+// and its error status is set to an InputValue-error.
+func (f *Polynomial) IncrementCoef(deg []uint, val ff.Element) {
+	if val.IsZero() {
+		return
+	}
+
+	if t, ok := f.coefs[degKey(deg)]; ok {
+		t.coef.Add(val)
+		if t.coef.IsZero() {
+			delete(f.coefs, degKey(deg))
+		}
+	} else {
+		f.SetCoefPtr(deg, val.Copy())
+	}
+}
+
+// DecrementCoef decrements the coefficient of the monomial with degree deg in f
+// by val.
+//
+// If deg does not contain an exponent for each variable, f is left unchanged
+// and its error status is set to an InputValue-error.
+func (f *Polynomial) DecrementCoef(deg []uint, val ff.Element) {
+	if val.IsZero() {
+		return
+	}
+
+	if t, ok := f.coefs[degKey(deg)]; ok {
+		t.coef.Sub(val)
+		if t.coef.IsZero() {
+			delete(f.coefs, degKey(deg))
+		}
+	} else {
+		f.SetCoefPtr(deg, val.Neg())
+	}
+}
+
+// removeCoef sets the given coefficient to zero.
+func (f *Polynomial) removeCoef(deg []uint) {
+	delete(f.coefs, degKey(deg))
+}
+
+// Copy returns a new polynomial object over the same ring and with the same
+// coefficients as f.
+func (f *Polynomial) Copy() *Polynomial {
+	h := f.baseRing.zeroWithCap(len(f.coefs))
+	for k, t := range f.coefs {
+		h.coefs[k] = term{deg: t.deg, coef: t.coef.Copy()}
+	}
+	return h
+}
+
+// Eval evaluates f at the given point.
+//
+// If point does not contain a coordinate for each variable, an InputValue-error
+// is returned.
+func (f *Polynomial) Eval(point []ff.Element) (ff.Element, error) {
+	const op = "Evaluating polynomial"
+
+	if len(point) != f.baseRing.NVars() {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Point %v does not match the %d variables of the ring",
+			point, f.baseRing.NVars(),
+		)
+	}
+
+	out := f.BaseField().Zero()
+	for _, t := range f.coefs {
+		tmp := t.coef.Copy()
+		for i, d := range t.deg {
+			if d > 0 {
+				tmp.Mult(point[i].Pow(d))
+			}
+		}
+		out.Add(tmp)
+	}
+	return out, nil
+}
+
+// Equal determines whether two polynomials are equal. That is, whether they are
+// defined over the same ring, and have the same coefficients.
+func (f *Polynomial) Equal(g *Polynomial) bool {
+	if f.baseRing != g.baseRing {
+		return false
+	}
+	if len(f.coefs) != len(g.coefs) {
+		return false
+	}
+	for k, tf := range f.coefs {
+		if tg, ok := g.coefs[k]; !ok || !tg.coef.Equal(tf.coef) {
+			return false
+		}
+	}
+	return true
+}
+
+// SortedDegrees returns a list containing the degrees is the support of f.
+//
+// The list is sorted according to the ring order with higher orders preceding
+// lower orders in the list.
+func (f *Polynomial) SortedDegrees() [][]uint {
+	degs := make([][]uint, 0, len(f.coefs))
+	for _, t := range f.coefs {
+		degs = append(degs, append([]uint{}, t.deg...))
+	}
+
+	if len(degs) > 1 {
+		sort.Slice(degs, func(i, j int) bool {
+			return (f.baseRing.ord(degs[i], degs[j]) > 0)
+		})
+	}
+	return degs
+}
+
+// ld returns the leading degree of f without copying.
+func (f *Polynomial) ld() []uint {
+	var ld []uint
+	for _, t := range f.coefs {
+		if ld == nil || f.baseRing.ord(t.deg, ld) == 1 {
+			ld = t.deg
+		}
+	}
+	if ld == nil {
+		return make([]uint, f.baseRing.NVars())
+	}
+	return ld
+}
+
+// Ld returns the leading degree of f.
+func (f *Polynomial) Ld() []uint {
+	return append([]uint{}, f.ld()...)
+}
+
+// TotalDegree returns the total degree of f. That is, the largest sum of the
+// exponents in a monomial of f. The zero polynomial has total degree 0.
+func (f *Polynomial) TotalDegree() uint {
+	out := uint(0)
+	for _, t := range f.coefs {
+		if d := totalDeg(t.deg); d > out {
+			out = d
+		}
+	}
+	return out
+}
+
+// Lc returns the leading coefficient of f.
+func (f *Polynomial) Lc() ff.Element {
+	return f.Coef(f.ld())
+}
+
+// lcPtr returns a pointer to the leading coefficient of f.
+func (f *Polynomial) lcPtr() ff.Element {
+	return f.coefPtr(f.ld())
+}
+
+// Lt returns the leading term of f.
+func (f *Polynomial) Lt() *Polynomial {
+	h := f.baseRing.Zero()
+	ld := f.ld()
+	if c := f.coefPtr(ld); c != nil {
+		h.coefs[degKey(ld)] = term{deg: ld, coef: c.Copy()}
+	}
+	return h
+}
+
+// IsZero determines whether f is the zero polynomial.
+func (f *Polynomial) IsZero() bool {
+	return len(f.coefs) == 0
+}
+
+// IsNonzero determines whether f contains some monomial with nonzero coefficient.
+func (f *Polynomial) IsNonzero() bool {
+	return !f.IsZero()
+}
+
+// IsMonomial returns a bool describing whether f consists of a single monomial.
+func (f *Polynomial) IsMonomial() bool {
+	return len(f.coefs) == 1
+}
+
+// Reduces f in-place and sets its error state if needed.
+func (f *Polynomial) reduce() {
+	const op = "Reducing polynomial"
+
+	if tmp := hasErr(op, f); tmp != nil {
+		return
+	}
+
+	if f.baseRing.id == nil {
+		return
+	}
+
+	err := f.baseRing.id.Reduce(f)
+	if err != nil {
+		f.err = err
+	}
+}
+
+// String returns the string representation of f. The variable names are those
+// given when defining the ring. To change them, see the SetVarNames method.
+func (f *Polynomial) String() string {
+	if f.IsZero() {
+		return "0"
+	}
+
+	degs := f.SortedDegrees()
+
+	var b strings.Builder
+	for i, d := range degs {
+		if i > 0 {
+			b.Write([]byte(" + "))
+		}
+
+		// Append the coefficient
+		if tmp := f.coefPtr(d); !tmp.IsOne() || totalDeg(d) == 0 {
+			if tmp.NTerms() > 1 {
+				b.WriteByte('(')
+				b.WriteString(tmp.String())
+				b.WriteByte(')')
+			} else {
+				b.WriteString(tmp.String())
+			}
+		}
+
+		// Append the variable names and their degrees. Since variable names
+		// may be longer than one character, they are separated by '*'
+		first := true
+		for j, e := range d {
+			if e == 0 {
+				continue
+			}
+			if !first {
+				b.WriteByte('*')
+			}
+			first = false
+			b.WriteString(f.baseRing.varNames[j])
+			if e > 1 {
+				b.WriteByte('^')
+				b.WriteString(strconv.FormatUint(uint64(e), 10))
+			}
+		}
+	}
+	return b.String()
+}
+
+// checkErrAndCompatible is a wrapper for the two functions hasErr and
+// checkCompatible. It is used in arithmetic functions to check that the inputs
+// are 'good' to use.
+func checkErrAndCompatible(op errors.Op, f *Polynomial, g ...*Polynomial) *Polynomial {
+	if tmp := hasErr(op, f); tmp != nil {
+		return tmp
+	}
+
+	if tmp := hasErr(op, g...); tmp != nil {
+		return tmp
+	}
+
+	if tmp := checkCompatible(op, f, g...); tmp != nil {
+		return tmp
+	}
+
+	return nil
+}
+
+// hasErr is an internal method for checking if one of the given polynomials has
+// a non-nil error field.
+//
+// It returns the first polynomial with non-nil error status after wrapping the
+// error. The new error inherits the kind from the old.
+func hasErr(op errors.Op, f ...*Polynomial) *Polynomial {
+	for _, g := range f {
+		if g.err != nil {
+			g.err = errors.Wrap(
+				op, errors.Inherit,
+				g.err,
+			)
+			return g
+		}
+	}
+	return nil
+}
+
+// checkCompatible is an internal method for checking if f and g are compatible;
+// that is, if they are defined over the same ring.
+//
+// If not, the return value is an element with error status set to
+// ArithmeticIncompat.
+func checkCompatible(op errors.Op, f *Polynomial, g ...*Polynomial) *Polynomial {
+	for _, h := range g {
+		if f.baseRing != h.baseRing {
+			out := f.baseRing.Zero()
+			out.err = errors.New(
+				op, errors.ArithmeticIncompat,
+				"%v and %v defined over different rings", f, g,
+			)
+			return out
+		}
+	}
+	return nil
+}
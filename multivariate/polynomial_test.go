@@ -0,0 +1,184 @@
+package multivariate
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+var prg = rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
+
+func TestAddAndSubDegs(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		a := []uint{uint(prg.Uint32()), uint(prg.Uint32()), uint(prg.Uint32())}
+		b := []uint{uint(prg.Uint32()), uint(prg.Uint32()), uint(prg.Uint32())}
+		sum, err := addDegs(a, b)
+		if err != nil {
+			t.Errorf("addDegs(%v, %v) returned error %v", a, b, err)
+		}
+		for j := range sum {
+			if sum[j] != a[j]+b[j] {
+				t.Errorf("addDegs(%v, %v) = %v", a, b, sum)
+			}
+		}
+
+		diff, ok := subtractDegs(a, b)
+		if ok != (a[0] >= b[0] && a[1] >= b[1] && a[2] >= b[2]) {
+			t.Errorf("subtractDegs(%v, %v) returned ok = %t", a, b, ok)
+		}
+		if ok {
+			for j := range diff {
+				if diff[j] != a[j]-b[j] {
+					t.Errorf("subtractDegs(%v, %v) = %v", a, b, diff)
+				}
+			}
+		}
+	}
+
+	if _, err := addDegs([]uint{^uint(0), 0}, []uint{1, 0}); err == nil {
+		t.Errorf("addDegs did not detect overflow")
+	} else if !errors.Is(errors.Overflow, err) {
+		t.Errorf("addDegs returned an error of unexpected kind (err = %v)", err)
+	}
+}
+
+func TestPow(t *testing.T) {
+	field := defineField(3, t)
+	r := defineRing(field, []string{"x", "y", "z"}, Lex(), t)
+	for _, d := range [][]uint{{0, 0, 0}, {1, 0, 0}, {1, 1, 2}, {0, 2, 1}} {
+		f := r.PolynomialFromUnsigned([][]uint{d}, []uint{2})
+		for n := uint(0); n < 5; n++ {
+			g := f.Pow(n)
+			if !g.IsMonomial() {
+				t.Errorf("Pow failed: %v^%d = %v is not a monomial", f, n, g)
+				continue
+			}
+			for i, e := range g.Ld() {
+				if e != n*d[i] {
+					t.Errorf("Pow failed: %v^%d = %v", f, n, g)
+				}
+			}
+			if expected := field.ElementFromUnsigned(2).Pow(n); !g.Lc().Equal(expected) {
+				t.Errorf("Pow failed: %v^%d = %v", f, n, g)
+			}
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	fieldLoop(func(field ff.Field) {
+		r := defineRing(field, []string{"x", "y", "z", "w"}, DegRevLex(), t)
+		for rep := 0; rep < 10; rep++ {
+			f := randomPolynomial(r, 5, 5)
+			g := randomPolynomial(r, 5, 5)
+			h := randomPolynomial(r, 5, 5)
+
+			if !f.Plus(g).Equal(g.Plus(f)) {
+				t.Errorf("f+g != g+f for f = %v and g = %v", f, g)
+			}
+			if !f.Times(g).Equal(g.Times(f)) {
+				t.Errorf("fg != gf for f = %v and g = %v", f, g)
+			}
+			if lhs, rhs := f.Times(g.Plus(h)), f.Times(g).Plus(f.Times(h)); !lhs.Equal(rhs) {
+				t.Errorf("f(g+h) != fg+fh for f = %v, g = %v and h = %v", f, g, h)
+			}
+			if !f.Minus(g).Plus(g).Equal(f) {
+				t.Errorf("(f-g)+g != f for f = %v and g = %v", f, g)
+			}
+			if !f.Plus(f.Neg()).IsZero() {
+				t.Errorf("f+(-f) != 0 for f = %v", f)
+			}
+			if f.IsNonzero() && !f.Normalize().Lc().IsOne() {
+				t.Errorf("Normalize(%v) = %v is not monic", f, f.Normalize())
+			}
+
+			// Division with remainder
+			q, rem, err := f.QuoRem(g, h)
+			if err != nil {
+				t.Fatalf("QuoRem failed: %v", err)
+			}
+			if sum := q[0].Times(g).Plus(q[1].Times(h)).Plus(rem); !sum.Equal(f) {
+				t.Errorf("QuoRem(%v, %v, %v) gave q = %v and r = %v", f, g, h, q, rem)
+			}
+			if rem2, _ := f.Rem(g, h); !rem2.Equal(rem) {
+				t.Errorf("Rem and QuoRem gave different remainders %v and %v", rem2, rem)
+			}
+		}
+	})
+}
+
+func TestEval(t *testing.T) {
+	fieldLoop(func(field ff.Field) {
+		r := defineRing(field, []string{"x", "y", "z"}, Lex(), t)
+		for rep := 0; rep < 10; rep++ {
+			f := randomPolynomial(r, 4, 4)
+			g := randomPolynomial(r, 4, 4)
+			p := []ff.Element{field.RandElement(), field.RandElement(), field.RandElement()}
+
+			fp, _ := f.Eval(p)
+			gp, _ := g.Eval(p)
+			if prod, _ := f.Times(g).Eval(p); !prod.Equal(fp.Times(gp)) {
+				t.Errorf("(fg)(p) != f(p)g(p) for f = %v, g = %v, p = %v", f, g, p)
+			}
+			if sum, _ := f.Plus(g).Eval(p); !sum.Equal(fp.Plus(gp)) {
+				t.Errorf("(f+g)(p) != f(p)+g(p) for f = %v, g = %v, p = %v", f, g, p)
+			}
+		}
+
+		_, err := r.One().Eval([]ff.Element{field.One()})
+		assertError(t, err, errors.InputValue, "Eval at point of wrong dimension")
+	})
+}
+
+func TestDegreeErrors(t *testing.T) {
+	field := defineField(5, t)
+	r := defineRing(field, []string{"x", "y"}, Lex(), t)
+
+	f := r.Polynomial([][]uint{{1, 2, 3}}, []ff.Element{field.One()})
+	assertError(t, f.Err(), errors.InputValue, "Polynomial with degree of wrong length")
+
+	f = r.PolynomialFromUnsigned([][]uint{{1, 2}}, []uint{1, 2})
+	assertError(t, f.Err(), errors.InputValue, "Polynomial with too many coefficients")
+
+	f = r.Zero()
+	f.SetCoef([]uint{1}, field.One())
+	assertError(t, f.Err(), errors.InputValue, "SetCoef with degree of wrong length")
+
+	f = r.Var(2)
+	assertError(t, f.Err(), errors.InputValue, "Var with index out of range")
+
+	// Errors propagate through arithmetic
+	g := r.One().Plus(f)
+	assertError(t, g.Err(), errors.InputValue, "Sum with erroneous polynomial")
+}
+
+func TestDiffRings(t *testing.T) {
+	field := defineField(5, t)
+	r1 := defineRing(field, []string{"x", "y"}, Lex(), t)
+	r2 := defineRing(field, []string{"x", "y"}, Lex(), t)
+	f, g := r1.Var(0), r2.Var(0)
+
+	assertError(t, f.Plus(g).Err(), errors.ArithmeticIncompat, "Plus over different rings")
+	assertError(t, f.Minus(g).Err(), errors.ArithmeticIncompat, "Minus over different rings")
+	assertError(t, f.Times(g).Err(), errors.ArithmeticIncompat, "Times over different rings")
+	_, _, err := f.QuoRem(g)
+	assertError(t, err, errors.ArithmeticIncompat, "QuoRem over different rings")
+	_, err = SPolynomial(f, g)
+	assertError(t, err, errors.ArithmeticIncompat, "SPolynomial over different rings")
+	assertError(t, f.EmbedIn(r2, true), errors.InputIncompatible, "EmbedIn into other ring")
+}
+
+func TestString(t *testing.T) {
+	field := defineField(7, t)
+	r := defineRing(field, []string{"x1", "x2", "x3"}, DegLex(), t)
+	f, err := r.PolynomialFromString("3x1^2x3 + x2 - 1 + x1*x2*x3")
+	if err != nil {
+		t.Fatalf("Parsing failed: %v", err)
+	}
+	if s, expected := f.String(), "3x1^2*x3 + x1*x2*x3 + x2 + 6"; s != expected {
+		t.Errorf("String returned %q, but expected %q", s, expected)
+	}
+}
@@ -0,0 +1,186 @@
+package multivariate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+type ring struct {
+	baseField ff.Field
+	varNames  []string
+	ord       Order
+}
+
+// QuotientRing denotes a polynomial quotient ring. The quotient may be trivial,
+// in which case, the object acts as a ring.
+type QuotientRing struct {
+	*ring
+	id *Ideal
+}
+
+// String returns the string representation of r.
+func (r *ring) String() string {
+	return fmt.Sprintf(
+		"Multivariate polynomial ring in %s over %v",
+		strings.Join(r.varNames, ", "), r.baseField,
+	)
+}
+
+// String returns the string representation of r.
+func (r *QuotientRing) String() string {
+	if r.id == nil {
+		return r.ring.String()
+	}
+	return fmt.Sprintf(
+		"Quotient ring of multivariate polynomials in %s over %v modulo %s",
+		strings.Join(r.varNames, ", "), r.baseField, r.id.ShortString(),
+	)
+}
+
+// DefRing defines a new polynomial ring over the given field, using the order
+// function ord. The number of variables is the number of variable names. The
+// variables are ordered as given, meaning that the first variable is the
+// largest with respect to lexicographical-type orderings.
+//
+// The variable names must satisfy the requirements described in SetVarNames.
+// Otherwise, an InputValue-error is returned.
+func DefRing(field ff.Field, varNames []string, ord Order) (*QuotientRing, error) {
+	const op = "Defining ring"
+
+	r := &QuotientRing{
+		ring: &ring{
+			baseField: field,
+			ord:       ord,
+		},
+		id: nil,
+	}
+	if err := r.SetVarNames(varNames); err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	return r, nil
+}
+
+// SetVarNames sets the variable names to be used in the given quotient ring.
+// The number of variable names must match the number of variables of the ring,
+// unless the ring is being defined.
+//
+// Leading and trailing whitespace characters are removed before setting the
+// variable names. If one of the strings consists solely of whitespace
+// characters, an InputValue-error is returned. The same is true if a name
+// contains whitespace, a sign, or any of the symbols '*', '^', '(', and ')',
+// or if it starts with a digit.
+//
+// If two variable names are identical when ignoring leading and trailing
+// whitespace and capitalization, an InputValue-error is returned.
+func (r *QuotientRing) SetVarNames(varNames []string) error {
+	const op = "Setting variable names"
+
+	if len(varNames) == 0 {
+		return errors.New(
+			op, errors.InputValue,
+			"At least one variable name must be given",
+		)
+	}
+	if r.varNames != nil && len(varNames) != len(r.varNames) {
+		return errors.New(
+			op, errors.InputValue,
+			"Expected %d variable names, but received %d",
+			len(r.varNames), len(varNames),
+		)
+	}
+
+	names := make([]string, len(varNames))
+	seen := make(map[string]bool, len(varNames))
+	for i, v := range varNames {
+		varName := strings.TrimSpace(v)
+		switch {
+		case len(varName) == 0:
+			return errors.New(
+				op, errors.InputValue,
+				"Cannot use whitespace characters as variable name",
+			)
+		case strings.ContainsAny(varName, " \t\n+-*^()") ||
+			(varName[0] >= '0' && varName[0] <= '9'):
+			return errors.New(
+				op, errors.InputValue,
+				"Variable name %q contains illegal characters", varName,
+			)
+		case seen[strings.ToLower(varName)]:
+			return errors.New(
+				op, errors.InputValue,
+				"Variable name %q is used more than once. Parsing from "+
+					"strings is unlikely to work.",
+				varName,
+			)
+		}
+		seen[strings.ToLower(varName)] = true
+		names[i] = varName
+	}
+
+	r.varNames = names
+	return nil
+}
+
+// VarNames returns a copy of the strings used to represent the variables of r.
+func (r *QuotientRing) VarNames() []string {
+	return append([]string{}, r.varNames...)
+}
+
+// NVars returns the number of variables of r.
+func (r *QuotientRing) NVars() int {
+	return len(r.varNames)
+}
+
+// BaseField returns the field over which the polynomials of r are defined.
+func (r *QuotientRing) BaseField() ff.Field {
+	return r.baseField
+}
+
+// Ideal returns the ideal defining the quotient ring r. If r is not a proper
+// quotient ring, nil is returned.
+func (r *QuotientRing) Ideal() *Ideal {
+	if r.id == nil {
+		return nil
+	}
+	return r.id.Copy()
+}
+
+// Quotient defines the quotient of the given ring modulo the input ideal.
+//
+// The return type is a new QuotientRing-object
+func (r *QuotientRing) Quotient(id *Ideal) (*QuotientRing, error) {
+	const op = "Define quotient ring"
+	if r.id != nil {
+		return r, errors.New(
+			op, errors.InputValue,
+			"Given ring is already reduced modulo an ideal",
+		)
+	}
+	if r.ring != id.ring {
+		return r, errors.New(
+			op, errors.InputIncompatible,
+			"Input argument not ideal of ring '%v'", r,
+		)
+	}
+	if id.isGroebner != 1 {
+		id = id.GroebnerBasis()
+	} else {
+		id = id.Copy()
+	}
+	_ = id.ReduceBasis() // Ignore error since id is a Gröbner basis
+
+	qr := &QuotientRing{
+		ring: r.ring,
+		id:   id,
+	}
+
+	// Mark the generators as belonging to the new ring, but do not reduce
+	for _, g := range qr.id.generators {
+		g.EmbedIn(qr, false)
+	}
+
+	return qr, nil
+}
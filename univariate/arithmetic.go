@@ -1,6 +1,8 @@
 package univariate
 
 import (
+	"math/bits"
+
 	"github.com/ReneBoedker/algobra/errors"
 	"github.com/ReneBoedker/algobra/finitefield/ff"
 )
@@ -20,12 +22,10 @@ func (f *Polynomial) Add(g *Polynomial) *Polynomial {
 		return f
 	}
 
-	for deg, c := range g.coefs {
-		if c == nil {
-			continue
-		}
-		f.IncrementCoef(deg, c)
+	if g == f {
+		g = g.Copy()
 	}
+	g.forEachTerm(f.IncrementCoef)
 	return f
 }
 
@@ -49,12 +49,9 @@ func (f *Polynomial) Neg() *Polynomial {
 // SetNeg returns the polynomial obtained by scaling f by -1 (modulo the
 // characteristic).
 func (f *Polynomial) SetNeg() *Polynomial {
-	for _, c := range f.coefs {
-		if c == nil {
-			continue
-		}
+	f.forEachTerm(func(_ int, c ff.Element) {
 		c.SetNeg()
-	}
+	})
 	return f
 }
 
@@ -64,21 +61,16 @@ func (f *Polynomial) Equal(g *Polynomial) bool {
 	if f.baseRing != g.baseRing {
 		return false
 	}
-	if len(f.coefs) != len(g.coefs) {
+	if f.Ld() != g.Ld() || f.IsZero() != g.IsZero() || f.NTerms() != g.NTerms() {
 		return false
 	}
-	for deg, cf := range f.coefs {
-		if cf == nil {
-			if g.Coef(deg).IsNonzero() {
-				return false
-			}
-			continue
-		}
-		if !g.Coef(deg).Equal(cf) {
-			return false
+	equal := true
+	f.forEachTerm(func(deg int, cf ff.Element) {
+		if cg := g.coefPtr(deg); cg == nil || !cg.Equal(cf) {
+			equal = false
 		}
-	}
-	return true
+	})
+	return equal
 }
 
 // Sub sets f to the polynomial difference f-g and returns f.
@@ -96,12 +88,10 @@ func (f *Polynomial) Sub(g *Polynomial) *Polynomial {
 		return f
 	}
 
-	for deg, c := range g.coefs {
-		if c == nil {
-			continue
-		}
-		f.DecrementCoef(deg, c)
+	if g == f {
+		g = g.Copy()
 	}
+	g.forEachTerm(f.DecrementCoef)
 	return f
 }
 
@@ -126,21 +116,15 @@ func (f *Polynomial) subWithShiftAndScale(g *Polynomial, i int, a ff.Element) {
 	case a.IsZero():
 		return
 	case a.IsOne():
-		for d, c := range g.coefs {
-			if c == nil || c.IsZero() {
-				continue
-			}
+		g.forEachTerm(func(d int, c ff.Element) {
 			f.DecrementCoef(d+i, c)
-		}
+		})
 	default:
 		tmp := f.BaseField().Zero()
-		for d, c := range g.coefs {
-			if c == nil || c.IsZero() {
-				continue
-			}
+		g.forEachTerm(func(d int, c ff.Element) {
 			tmp.Prod(a, c)
 			f.DecrementCoef(d+i, tmp)
-		}
+		})
 	}
 }
 
@@ -157,6 +141,16 @@ func (f *Polynomial) multNoReduce(g *Polynomial) *Polynomial {
 		return f.baseRing.Zero()
 	}
 
+	if f.sparse != nil || g.sparse != nil {
+		return f.multSparse(g)
+	}
+
+	if f.Ld() >= karatsubaThreshold && g.Ld() >= karatsubaThreshold {
+		return f.baseRing.fromDense(
+			mulCoefs(f.BaseField(), f.Coefs(), g.Coefs()),
+		)
+	}
+
 	h := f.baseRing.zeroWithCap(f.Ld() + g.Ld() + 1)
 	tmp := f.BaseField().Zero()
 	for degf, cf := range f.coefs {
@@ -219,17 +213,84 @@ func (f *Polynomial) Mult(g *Polynomial) *Polynomial {
 
 // SetZero sets f to the zero polynomial.
 func (f *Polynomial) SetZero() {
+	if f.sparse != nil {
+		f.sparse = nil
+		f.coefs = []ff.Element{f.BaseField().Zero()}
+		return
+	}
 	f.coefs[0].SetUnsigned(0)
 	f.coefs = f.coefs[:1]
 }
 
 // Pow raises f to the power of n.
 //
+// When it is cheap to do so, the exponent is processed in base p, where p is
+// the characteristic, and the p'th powers are computed using the Frobenius map.
+// In particular, sparse polynomials stay sparse when raised to a power of p.
+// Otherwise, f is raised to the power of n using repeated squaring.
+//
 // If the computation causes the degree of f to overflow, the returned
 // polynomial has an Overflow-error as error status.
 func (f *Polynomial) Pow(n uint) *Polynomial {
 	const op = "Computing polynomial power"
 
+	wrapErr := func(err error) *Polynomial {
+		out := f.baseRing.Zero()
+		out.err = errors.Wrap(op, errors.Inherit, err)
+		return out
+	}
+
+	if !f.baseRing.frobeniusIsCheap() {
+		out := f.powBinary(n)
+		if out.Err() != nil {
+			return wrapErr(out.Err())
+		}
+		return out
+	}
+
+	p := f.BaseField().Char()
+	out := f.baseRing.Polynomial([]ff.Element{
+		f.BaseField().One(),
+	})
+	g := f.Copy()
+
+	for n > 0 {
+		if d := n % p; d > 0 {
+			out.Mult(g.powBinary(d))
+			if out.Err() != nil {
+				return wrapErr(out.Err())
+			}
+		}
+		n /= p
+		if n > 0 {
+			g = g.frobenius()
+			if g.Err() != nil {
+				return wrapErr(g.Err())
+			}
+		}
+	}
+	return out
+}
+
+// frobeniusIsCheap reports whether p'th powers in r are computed faster using
+// the Frobenius map than using repeated squaring.
+//
+// Modulo a generator of degree d with t terms, the image of the Frobenius map
+// has degree up to p*d, and reducing it term by term costs about p*d*t
+// operations. Repeated squaring costs about 2*log(p)*d^2 operations, but never
+// creates intermediate results of degree above 2*d.
+func (r *QuotientRing) frobeniusIsCheap() bool {
+	if r.id == nil {
+		return true
+	}
+	p := r.baseField.Char()
+	d := uint(r.id.generator.Ld())
+	t := r.id.generator.NTerms()
+	return p*t <= 2*uint(bits.Len(p))*d
+}
+
+// powBinary raises f to the power of n using repeated squaring.
+func (f *Polynomial) powBinary(n uint) *Polynomial {
 	out := f.baseRing.Polynomial([]ff.Element{
 		f.BaseField().One(),
 	})
@@ -239,17 +300,85 @@ func (f *Polynomial) Pow(n uint) *Polynomial {
 		if n%2 == 1 {
 			out = out.Mult(g)
 			if out.Err() != nil {
-				out = f.baseRing.Zero()
-				out.err = errors.Wrap(op, errors.Inherit, out.Err())
 				return out
 			}
 		}
 		n /= 2
-		g = g.Mult(g)
+		if n > 0 {
+			g = g.Mult(g)
+		}
 	}
 	return out
 }
 
+// frobenius returns f^p, where p is the characteristic. This is computed by
+// raising each coefficient to the p'th power and multiplying each degree by p.
+func (f *Polynomial) frobenius() *Polynomial {
+	const op = "Applying Frobenius map"
+
+	p := int(f.BaseField().Char())
+	h := f.baseRing.Zero()
+	overflow := false
+	f.forEachTerm(func(deg int, c ff.Element) {
+		if deg != 0 && (deg*p)/p != deg {
+			overflow = true
+			return
+		}
+		h.SetCoefPtr(deg*p, c.Pow(uint(p)))
+	})
+	if overflow {
+		h = f.baseRing.Zero()
+		h.err = errors.New(
+			op, errors.Overflow,
+			"Degree %v * %v overflows int", f.Ld(), p,
+		)
+		return h
+	}
+	h.reduce()
+	return h
+}
+
+// Inv returns the multiplicative inverse of f.
+//
+// If f is defined over a quotient ring, the inverse is computed modulo the
+// generator of the ideal. Otherwise, only the non-zero constants are
+// invertible. When f is not a unit, the returned polynomial has an
+// InputValue-error as error status.
+func (f *Polynomial) Inv() *Polynomial {
+	const op = "Inverting polynomial"
+
+	if tmp := hasErr(op, f); tmp != nil {
+		return tmp
+	}
+
+	notUnit := func() *Polynomial {
+		out := f.baseRing.Zero()
+		out.err = errors.New(
+			op, errors.InputValue,
+			"%v is not a unit in %v", f, f.baseRing,
+		)
+		return out
+	}
+
+	if f.baseRing.id == nil {
+		if f.Ld() > 0 || f.IsZero() {
+			return notUnit()
+		}
+		return f.baseRing.Polynomial([]ff.Element{f.lcPtr().Inv()})
+	}
+
+	d, s, _, err := ExtendedGcd(f, f.baseRing.id.generator)
+	if err != nil {
+		out := f.baseRing.Zero()
+		out.err = errors.Wrap(op, errors.Inherit, err)
+		return out
+	}
+	if !d.IsOne() {
+		return notUnit()
+	}
+	return s
+}
+
 // QuoRem returns the polynomial quotient and remainder under division by the
 // given list of polynomials.
 func (f *Polynomial) QuoRem(list ...*Polynomial) (q []*Polynomial, r *Polynomial, err error) {
@@ -70,3 +70,98 @@ func BenchmarkEvalPrime(b *testing.B) {
 	field, _ := finitefield.Define(113)
 	benchEval(field, b)
 }
+
+func benchInterpolate(field ff.Field, n int, b *testing.B) {
+	ring := univariate.DefRing(field)
+
+	points := field.Elements()[:n]
+	values := make([]ff.Element, n)
+	for i := range values {
+		values[i] = field.RandElement()
+	}
+
+	b.ResetTimer()
+	for rep := 0; rep < b.N; rep++ {
+		_, err := ring.Interpolate(points, values)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkInterpolate256Binfield(b *testing.B) {
+	field, _ := finitefield.Define(256)
+	benchInterpolate(field, 256, b)
+}
+
+func BenchmarkInterpolate1000Prime(b *testing.B) {
+	field, _ := finitefield.Define(1031)
+	benchInterpolate(field, 1000, b)
+}
+
+func benchEvalMulti(field ff.Field, n int, b *testing.B) {
+	ring := univariate.DefRing(field)
+
+	coefs := make([]ff.Element, n)
+	for i := range coefs {
+		coefs[i] = field.RandElement()
+	}
+	f := ring.Polynomial(coefs)
+	points := field.Elements()[:n]
+
+	b.ResetTimer()
+	for rep := 0; rep < b.N; rep++ {
+		f.EvalMulti(points)
+	}
+}
+
+func BenchmarkEvalMulti1000Prime(b *testing.B) {
+	field, _ := finitefield.Define(1031)
+	benchEvalMulti(field, 1000, b)
+}
+
+func benchPowQuotient(field ff.Field, deg int, b *testing.B) {
+	ring := univariate.DefRing(field)
+
+	// Use a dense generator, for which the Frobenius map creates large
+	// intermediate results
+	genCoefs := make([]ff.Element, deg+1)
+	for i := range genCoefs {
+		genCoefs[i] = field.RandElement()
+		for genCoefs[i].IsZero() {
+			genCoefs[i] = field.RandElement()
+		}
+	}
+	id, err := ring.NewIdeal(ring.Polynomial(genCoefs))
+	if err != nil {
+		b.Fatal(err)
+	}
+	qRing, err := ring.Quotient(id)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	coefs := make([]ff.Element, deg)
+	for i := range coefs {
+		coefs[i] = field.RandElement()
+	}
+	f := qRing.Polynomial(coefs)
+	q := field.Card()
+
+	b.ResetTimer()
+	for rep := 0; rep < b.N; rep++ {
+		if g := f.Pow(q*q + 5); g.Err() != nil {
+			b.Fatal(g.Err())
+		}
+	}
+}
+
+func BenchmarkPowQuotient1031(b *testing.B) {
+	field, _ := finitefield.Define(1031)
+	benchPowQuotient(field, 60, b)
+}
+
+func BenchmarkPowQuotient65521(b *testing.B) {
+	field, _ := finitefield.Define(65521)
+	benchPowQuotient(field, 60, b)
+}
@@ -0,0 +1,158 @@
+package univariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// Compose returns the composition f(g(X)).
+//
+// If f and g are defined over a quotient ring, the composition is computed
+// modulo the generator of the ideal using ModComposition. Note that the result
+// depends on the representative of f, which is always reduced in this case.
+//
+// If f and g are defined over different rings, the returned polynomial has an
+// ArithmeticIncompat-error as error status.
+func (f *Polynomial) Compose(g *Polynomial) *Polynomial {
+	const op = "Composing polynomials"
+
+	if tmp := checkErrAndCompatible(op, f, g); tmp != nil {
+		return tmp
+	}
+
+	if f.baseRing.id != nil {
+		return f.ModComposition(g, f.baseRing.id.generator)
+	}
+
+	// Use Horner's rule
+	out := f.baseRing.Zero()
+	for i := f.Ld(); i >= 0; i-- {
+		out = out.multNoReduce(g)
+		if out.Err() != nil {
+			out.err = errors.Wrap(op, errors.Inherit, out.err)
+			return out
+		}
+		if !f.coefIsZero(i) {
+			out.IncrementCoef(0, f.coefPtr(i))
+		}
+	}
+	return out
+}
+
+// ModComposition returns the composition f(g(X)) modulo h.
+//
+// The computation uses the baby-step giant-step algorithm of Brent and Kung,
+// which requires roughly sqrt(deg(f)) multiplications modulo h in addition to
+// a number of scalar operations. The result is reduced modulo the ideal of the
+// ring, if any.
+//
+// If the polynomials are defined over different rings, the returned polynomial
+// has an ArithmeticIncompat-error as error status. If h is zero, the error
+// status is an InputValue-error.
+func (f *Polynomial) ModComposition(g, h *Polynomial) *Polynomial {
+	// The implementation is based on [GG13; Algorithm 12.4]
+	const op = "Computing modular composition"
+
+	if tmp := checkErrAndCompatible(op, f, g, h); tmp != nil {
+		return tmp
+	}
+	if h.IsZero() {
+		out := f.baseRing.Zero()
+		out.err = errors.New(
+			op, errors.InputValue,
+			"Cannot reduce modulo the zero polynomial",
+		)
+		return out
+	}
+
+	out := f.baseRing.fromDense(
+		newModComposer(f.BaseField(), g.Coefs(), h.Coefs()).compose(f.Coefs()),
+	)
+	out.reduce()
+	return out
+}
+
+// modComposer precomputes the data needed for computing compositions f(g)
+// modulo h for a fixed g and h.
+type modComposer struct {
+	field ff.Field
+	h     []ff.Element
+	hInv  []ff.Element
+	// Powers g^0, g^1, ..., g^m modulo h. The final power is used for the
+	// giant steps
+	baby [][]ff.Element
+}
+
+// newModComposer returns a modComposer for g and h. The polynomial h must be
+// non-zero.
+func newModComposer(field ff.Field, g, h []ff.Element) *modComposer {
+	h = trimCoefs(h)
+	c := &modComposer{
+		field: field,
+		h:     h,
+	}
+	if d := len(h) - 1; d >= karatsubaThreshold {
+		c.hInv = invSeries(field, reverseCoefs(field, h, len(h)), d)
+	}
+	c.baby = [][]ff.Element{c.rem([]ff.Element{field.One()}), c.rem(g)}
+	return c
+}
+
+// rem reduces a modulo h.
+func (c *modComposer) rem(a []ff.Element) []ff.Element {
+	return remCoefs(c.field, a, c.h, c.hInv)
+}
+
+// mulMod returns a*b modulo h.
+func (c *modComposer) mulMod(a, b []ff.Element) []ff.Element {
+	return c.rem(mulCoefs(c.field, a, b))
+}
+
+// compose returns f(g) modulo h.
+func (c *modComposer) compose(f []ff.Element) []ff.Element {
+	f = trimCoefs(f)
+	if len(f) == 0 || len(c.h) == 1 {
+		return []ff.Element{}
+	}
+
+	// Choose the number of baby steps m as roughly sqrt(len(f))
+	m := 1
+	for m*m < len(f) {
+		m++
+	}
+	for len(c.baby) <= m {
+		c.baby = append(c.baby, c.mulMod(c.baby[len(c.baby)-1], c.baby[1]))
+	}
+	giant := c.baby[m]
+
+	// Write f as the sum of F_j*X^(jm) where each F_j has degree less than m.
+	// Then use Horner's rule with respect to g^m
+	d := len(c.h) - 1
+	var out []ff.Element
+	tmp := c.field.Zero()
+	for j := (len(f) - 1) / m; j >= 0; j-- {
+		if out != nil {
+			out = c.mulMod(out, giant)
+		}
+
+		// Compute F_j(g) as a linear combination of the baby steps
+		block := zeroSlice(c.field, d)
+		for i := 0; i < m && j*m+i < len(f); i++ {
+			coef := f[j*m+i]
+			if coef.IsZero() {
+				continue
+			}
+			for k, b := range c.baby[i] {
+				block[k].Add(tmp.Prod(coef, b))
+			}
+		}
+
+		if out == nil {
+			out = block
+		} else {
+			addCoefsTo(block, out, 0)
+			out = block
+		}
+	}
+	return out
+}
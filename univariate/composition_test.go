@@ -0,0 +1,89 @@
+package univariate_test
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func TestCompose(t *testing.T) {
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+		for rep := 0; rep < 10; rep++ {
+			f := randomPolynomial(ring, prg.Intn(15))
+			g := randomPolynomial(ring, prg.Intn(5))
+
+			fg := f.Compose(g)
+			x := field.RandElement()
+			if !fg.Eval(x).Equal(f.Eval(g.Eval(x))) {
+				t.Errorf("Composition of %v and %v was %v", f, g, fg)
+			}
+
+			h := randomPolynomial(ring, prg.Intn(10))
+			if h.IsZero() {
+				continue
+			}
+			_, expected, _ := fg.QuoRem(h)
+			if m := f.ModComposition(g, h); !m.Equal(expected) {
+				t.Errorf("Composition of %v and %v modulo %v was %v, but "+
+					"expected %v", f, g, h, m, expected)
+			}
+		}
+	}
+	fieldLoop(do)
+}
+
+func TestModCompositionLarge(t *testing.T) {
+	field := defineField(101)
+	ring := univariate.DefRing(field)
+
+	h := randomPolynomial(ring, 80)
+	h.SetCoef(80, field.One())
+	id, err := ring.NewIdeal(h)
+	if err != nil {
+		t.Fatalf("Failed to define ideal: %q", err)
+	}
+	qRing, err := ring.Quotient(id)
+	if err != nil {
+		t.Fatalf("Failed to define quotient ring: %q", err)
+	}
+
+	for rep := 0; rep < 5; rep++ {
+		f := randomPolynomial(ring, 150)
+		g := randomPolynomial(ring, 79)
+
+		// Compute the expected result using Horner's rule modulo h
+		expected := ring.Zero()
+		for i := f.Ld(); i >= 0; i-- {
+			_, expected, _ = expected.Times(g).QuoRem(h)
+			expected = expected.Plus(ring.Polynomial([]ff.Element{f.Coef(i)}))
+		}
+
+		if m := f.ModComposition(g, h); !m.Equal(expected) {
+			t.Errorf("ModComposition returned %v, but expected %v", m, expected)
+		}
+
+		// In the quotient ring, f is replaced by its remainder modulo h
+		_, fRem, _ := f.QuoRem(h)
+		fq, gq := qRing.Polynomial(fRem.Coefs()), qRing.Polynomial(g.Coefs())
+		if c := fq.Compose(gq); c.String() != fRem.ModComposition(g, h).String() {
+			t.Errorf("Compose in quotient ring returned %v, but expected %v",
+				c, fRem.ModComposition(g, h))
+		}
+	}
+
+	if m := ring.One().ModComposition(ring.One(), ring.Zero()); m.Err() == nil {
+		t.Errorf("ModComposition succeeded modulo zero")
+	} else if !errors.Is(errors.InputValue, m.Err()) {
+		t.Errorf("ModComposition returned an error of unexpected kind "+
+			"(err = %v)", m.Err())
+	}
+	if c := ring.One().Compose(qRing.One()); c.Err() == nil {
+		t.Errorf("Compose succeeded for polynomials from different rings")
+	} else if !errors.Is(errors.ArithmeticIncompat, c.Err()) {
+		t.Errorf("Compose returned an error of unexpected kind (err = %v)",
+			c.Err())
+	}
+}
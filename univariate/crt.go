@@ -0,0 +1,83 @@
+package univariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+)
+
+// CRT uses the Chinese remainder theorem to compute the unique polynomial f of
+// degree less than the degree of the product of the moduli such that f is
+// congruent to residues[i] modulo moduli[i] for each i.
+//
+// It returns an InputValue-error if the number of residues and moduli differ,
+// if no moduli are given, or if the moduli are not pairwise coprime. If the
+// polynomials are not defined over the same ring, an InputIncompatible-error is
+// returned.
+func CRT(residues, moduli []*Polynomial) (*Polynomial, error) {
+	// The implementation is based on [GG13; Algorithm 5.4] with the moduli
+	// combined one at a time
+	const op = "Computing Chinese remaindering"
+
+	if len(residues) != len(moduli) {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Different number of residues and moduli (%d and %d)",
+			len(residues), len(moduli),
+		)
+	}
+	if len(moduli) == 0 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"At least one modulus is required",
+		)
+	}
+
+	r := moduli[0].baseRing
+	for i := range moduli {
+		if moduli[i].baseRing != r || residues[i].baseRing != r {
+			return nil, errors.New(
+				op, errors.InputIncompatible,
+				"Polynomials defined over different rings",
+			)
+		}
+		if tmp := hasErr(op, moduli[i], residues[i]); tmp != nil {
+			return nil, tmp.Err()
+		}
+		if moduli[i].IsZero() {
+			return nil, errors.New(
+				op, errors.InputValue,
+				"Modulus %d is zero", i,
+			)
+		}
+	}
+
+	// Invariant: f is the solution for the first i moduli, and m is their
+	// product
+	_, f, _ := residues[0].QuoRem(moduli[0])
+	m := moduli[0].Copy()
+
+	for i := 1; i < len(moduli); i++ {
+		d, s, _, err := ExtendedGcd(m, moduli[i])
+		if err != nil {
+			return nil, errors.Wrap(op, errors.Inherit, err)
+		}
+		if !d.IsOne() {
+			return nil, errors.New(
+				op, errors.InputValue,
+				"Moduli are not pairwise coprime; %v divides modulus %d",
+				d, i,
+			)
+		}
+
+		// Here, s is the inverse of m modulo moduli[i]. Hence, adding
+		// m*(residues[i]-f)*s to f gives the solution modulo m*moduli[i].
+		_, c, _ := residues[i].Minus(f).multNoReduce(s).QuoRem(moduli[i])
+		f.Add(m.multNoReduce(c))
+		m = m.multNoReduce(moduli[i])
+	}
+
+	f.reduce()
+	if f.Err() != nil {
+		return nil, errors.Wrap(op, errors.Inherit, f.Err())
+	}
+	return f, nil
+}
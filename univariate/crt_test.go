@@ -0,0 +1,92 @@
+package univariate_test
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func TestCRT(t *testing.T) {
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+
+		// The moduli X-u for distinct u are pairwise coprime, and the CRT
+		// solution is the interpolation polynomial. Add an irreducible
+		// quadratic modulus as well.
+		for rep := 0; rep < 10; rep++ {
+			n := prg.Intn(int(field.Card())) + 1
+			if n > 6 {
+				n = 6
+			}
+			points := field.Elements()[:n]
+			moduli := make([]*univariate.Polynomial, 0, n+1)
+			residues := make([]*univariate.Polynomial, 0, n+1)
+			for _, p := range points {
+				moduli = append(moduli, ring.Polynomial([]ff.Element{
+					p.Neg(), field.One(),
+				}))
+				residues = append(residues, ring.Polynomial([]ff.Element{
+					field.RandElement(), field.RandElement(),
+				}))
+			}
+
+			var quad *univariate.Polynomial
+			for quad == nil || !quad.IsIrreducible() {
+				quad = ring.Polynomial([]ff.Element{
+					field.RandElement(), field.RandElement(), field.One(),
+				})
+			}
+			moduli = append(moduli, quad)
+			residues = append(residues, ring.Polynomial([]ff.Element{
+				field.RandElement(), field.RandElement(), field.RandElement(),
+				field.RandElement(),
+			}))
+
+			f, err := univariate.CRT(residues, moduli)
+			if err != nil {
+				t.Errorf("CRT returned an error: %q", err)
+				continue
+			}
+			if f.Ld() >= n+2 {
+				t.Errorf("CRT returned %v of degree %d, but expected degree "+
+					"less than %d", f, f.Ld(), n+2)
+			}
+			for i := range moduli {
+				_, r1, _ := f.QuoRem(moduli[i])
+				_, r2, _ := residues[i].QuoRem(moduli[i])
+				if !r1.Equal(r2) {
+					t.Errorf("CRT solution %v is %v modulo %v, but expected %v",
+						f, r1, moduli[i], r2)
+				}
+			}
+		}
+	}
+	fieldLoop(do, 3)
+
+	field := defineField(5)
+	ring := univariate.DefRing(field)
+	_, err := univariate.CRT(
+		[]*univariate.Polynomial{ring.One(), ring.Zero()},
+		[]*univariate.Polynomial{
+			ring.PolynomialFromSigned([]int{-1, 0, 1}),
+			ring.PolynomialFromSigned([]int{1, 1}),
+		},
+	)
+	if err == nil {
+		t.Errorf("CRT returned no error for moduli that are not coprime")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("CRT returned an error of unexpected kind (err = %v)", err)
+	}
+
+	_, err = univariate.CRT(
+		[]*univariate.Polynomial{ring.One()},
+		[]*univariate.Polynomial{ring.One(), ring.One()},
+	)
+	if err == nil {
+		t.Errorf("CRT returned no error for different number of inputs")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("CRT returned an error of unexpected kind (err = %v)", err)
+	}
+}
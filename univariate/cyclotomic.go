@@ -0,0 +1,277 @@
+package univariate
+
+import (
+	"math/bits"
+
+	"github.com/ReneBoedker/algobra/auxmath"
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// CyclotomicCosets returns the q-cyclotomic cosets modulo n. That is, the
+// equivalence classes of {0, 1, ..., n-1} under the relation s ~ s*q mod n.
+//
+// The cosets are sorted according to their smallest element, and each coset is
+// listed as s, s*q, s*q^2, ... where s is its smallest element.
+//
+// If n is zero or if q and n are not coprime, an InputValue-error is returned.
+func CyclotomicCosets(q, n uint) ([][]uint, error) {
+	const op = "Computing cyclotomic cosets"
+
+	if n == 0 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Modulus must be positive",
+		)
+	}
+	if auxmath.Gcd(q, n) != 1 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"%d and %d are not coprime", q, n,
+		)
+	}
+
+	seen := make([]bool, n)
+	cosets := make([][]uint, 0)
+	for s := uint(0); s < n; s++ {
+		if seen[s] {
+			continue
+		}
+		coset := make([]uint, 0, 1)
+		for i := s; !seen[i]; i = mulMod(i, q, n) {
+			seen[i] = true
+			coset = append(coset, i)
+		}
+		cosets = append(cosets, coset)
+	}
+	return cosets, nil
+}
+
+// mulMod returns a*b mod n without overflowing.
+func mulMod(a, b, n uint) uint {
+	hi, lo := bits.Mul(a%n, b%n)
+	_, rem := bits.Div(hi, lo, n)
+	return rem
+}
+
+// CosetFactor is an irreducible factor of X^n-1 labelled by the cyclotomic
+// coset of the exponents of its roots.
+type CosetFactor struct {
+	Coset   []uint
+	MinPoly *Polynomial
+}
+
+// CyclotomicFactors returns the factorization of X^n-1 into irreducible
+// polynomials over the base field of r. Let q be the cardinality of this field.
+// Each factor is the minimal polynomial of b^s for some s, where b is a fixed
+// primitive n'th root of unity in an extension field. The factor is labelled
+// by the q-cyclotomic coset modulo n containing s, and its roots are b^i for i
+// in the coset.
+//
+// The factors are listed in the same order as the cosets from
+// CyclotomicCosets. Note that the labelling depends on the choice of b. This
+// choice is deterministic, so repeated calls return the same labelling.
+//
+// If n is zero or not coprime to q, an InputValue-error is returned. If the
+// necessary extension field is too large, an InputTooLarge-error is returned.
+func (r *QuotientRing) CyclotomicFactors(n uint) ([]CosetFactor, error) {
+	const op = "Factoring X^n - 1"
+
+	q := r.baseField.Card()
+	cosets, err := CyclotomicCosets(q, n)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	powers, err := rootOfUnityPowers(r.baseField, n)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	out := make([]CosetFactor, len(cosets))
+	for i, c := range cosets {
+		f, err := r.minPolyFromRoots(powers, c)
+		if err != nil {
+			return nil, errors.Wrap(op, errors.Inherit, err)
+		}
+		out[i] = CosetFactor{
+			Coset:   c,
+			MinPoly: f,
+		}
+	}
+	return out, nil
+}
+
+// rootOfUnityPowers returns the powers b^0, b^1, ..., b^(n-1) of a primitive
+// n'th root of unity b. The powers are represented as polynomials modulo an
+// irreducible polynomial of degree m, where m is the multiplicative order of q
+// modulo n. The cardinality q of the field must be coprime to n.
+func rootOfUnityPowers(field ff.Field, n uint) ([]*Polynomial, error) {
+	const op = "Computing primitive root of unity"
+
+	q := field.Card()
+
+	// Find the degree of the extension
+	m := uint(1)
+	for qm := q % n; qm != 1%n; qm = mulMod(qm, q, n) {
+		m++
+	}
+
+	card, err := auxmath.Pow(q, m)
+	if err != nil {
+		return nil, errors.New(
+			op, errors.InputTooLarge,
+			"Extension of degree %d of %v is too large", m, field,
+		)
+	}
+
+	// Represent the extension field as a quotient ring. The modulus is the
+	// first monic irreducible polynomial of degree m when the coefficients are
+	// enumerated in the order of field.Elements
+	elems := field.Elements()
+	ring := DefRing(field)
+	var modulus *Polynomial
+	for idx := make([]int, m); modulus == nil || !modulus.IsIrreducible(); nextIndex(idx, len(elems)) {
+		coefs := make([]ff.Element, m+1)
+		for i, j := range idx {
+			coefs[i] = elems[j]
+		}
+		coefs[m] = field.One()
+		modulus = ring.Polynomial(coefs)
+	}
+	id, err := ring.NewIdeal(modulus)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	ext, err := ring.Quotient(id)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	// An element b = a^((q^m-1)/n) has order dividing n. Enumerate the
+	// elements a in the same order until the order is exactly n
+	primes, _ := auxmath.Factorize(n)
+	var b *Polynomial
+outer:
+	for idx := make([]int, m); ; nextIndex(idx, len(elems)) {
+		coefs := make([]ff.Element, m)
+		for i, j := range idx {
+			coefs[i] = elems[j]
+		}
+		a := ext.Polynomial(coefs)
+		if a.IsZero() {
+			continue
+		}
+		b = a.Pow((card - 1) / n)
+		for _, p := range primes {
+			if b.Pow(n / p).IsOne() {
+				continue outer
+			}
+		}
+		break
+	}
+
+	powers := make([]*Polynomial, n)
+	powers[0] = ext.One()
+	for i := uint(1); i < n; i++ {
+		powers[i] = powers[i-1].Times(b)
+	}
+	return powers, nil
+}
+
+// nextIndex advances idx to the next tuple of indices in {0, 1, ..., q-1}, with
+// the first index changing fastest. After the last tuple, it wraps around to
+// the first.
+func nextIndex(idx []int, q int) {
+	for i := range idx {
+		idx[i]++
+		if idx[i] < q {
+			return
+		}
+		idx[i] = 0
+	}
+}
+
+// minPolyFromRoots computes the product of X-powers[i] for i in the coset.
+// Since the coset is closed under the Frobenius map, the coefficients belong
+// to the base field of r.
+func (r *QuotientRing) minPolyFromRoots(powers []*Polynomial, coset []uint) (*Polynomial, error) {
+	const op = "Computing minimal polynomial from roots"
+
+	ext := powers[0].baseRing
+	coefs := []*Polynomial{ext.One()}
+	for _, i := range coset {
+		next := make([]*Polynomial, len(coefs)+1)
+		next[len(coefs)] = coefs[len(coefs)-1].Copy()
+		for j := len(coefs) - 1; j > 0; j-- {
+			next[j] = coefs[j-1].Minus(powers[i].Times(coefs[j]))
+		}
+		next[0] = powers[i].Times(coefs[0]).SetNeg()
+		coefs = next
+	}
+
+	out := make([]ff.Element, len(coefs))
+	for i, c := range coefs {
+		if c.Ld() > 0 {
+			return nil, errors.New(
+				op, errors.Internal,
+				"Coefficient %v does not belong to the base field", c,
+			)
+		}
+		out[i] = c.Coef(0)
+	}
+	return r.Polynomial(out), nil
+}
+
+// Cyclotomic returns the n'th cyclotomic polynomial over r. That is, the
+// reduction of the polynomial whose roots are the primitive n'th roots of unity
+// over the complex numbers.
+//
+// The polynomial is computed as the product of (X^(n/e)-1)^mu(e) where e runs
+// through the square-free divisors of n and mu is the Möbius function.
+//
+// If n is zero, the returned polynomial has an InputValue-error as error
+// status.
+func (r *QuotientRing) Cyclotomic(n uint) *Polynomial {
+	const op = "Computing cyclotomic polynomial"
+
+	if n == 0 {
+		out := r.Zero()
+		out.err = errors.New(
+			op, errors.InputValue,
+			"Index of cyclotomic polynomial must be positive",
+		)
+		return out
+	}
+
+	primes, _ := auxmath.Factorize(n)
+	num, den := r.One(), r.One()
+	for subset := 0; subset < 1<<len(primes); subset++ {
+		e, sign := uint(1), 1
+		for i, p := range primes {
+			if subset&(1<<i) != 0 {
+				e *= p
+				sign = -sign
+			}
+		}
+
+		f := r.zeroWithCap(2)
+		f.SetCoefPtr(int(n/e), r.baseField.One())
+		f.DecrementCoef(0, r.baseField.One())
+		if sign > 0 {
+			num = num.multNoReduce(f)
+		} else {
+			den = den.multNoReduce(f)
+		}
+	}
+
+	q, _, err := num.QuoRem(den)
+	if err != nil {
+		out := r.Zero()
+		out.err = errors.Wrap(op, errors.Inherit, err)
+		return out
+	}
+	out := q[0]
+	out.reduce()
+	return out
+}
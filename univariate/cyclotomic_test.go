@@ -0,0 +1,141 @@
+package univariate_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ReneBoedker/algobra/auxmath"
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func TestCyclotomicCosets(t *testing.T) {
+	cosets, err := univariate.CyclotomicCosets(2, 15)
+	if err != nil {
+		t.Fatalf("CyclotomicCosets returned an error: %q", err)
+	}
+	expected := [][]uint{{0}, {1, 2, 4, 8}, {3, 6, 12, 9}, {5, 10}, {7, 14, 13, 11}}
+	if fmt.Sprint(cosets) != fmt.Sprint(expected) {
+		t.Errorf("CyclotomicCosets(2, 15) returned %v, but expected %v",
+			cosets, expected)
+	}
+
+	for _, in := range [][2]uint{{2, 0}, {3, 12}, {4, 6}} {
+		if _, err := univariate.CyclotomicCosets(in[0], in[1]); err == nil {
+			t.Errorf("CyclotomicCosets(%d, %d) returned no error", in[0], in[1])
+		} else if !errors.Is(errors.InputValue, err) {
+			t.Errorf("CyclotomicCosets returned an error of unexpected kind "+
+				"(err = %v)", err)
+		}
+	}
+}
+
+func TestCyclotomicFactors(t *testing.T) {
+	for _, card := range [...]uint{2, 3, 4, 5, 9, 16} {
+		field := defineField(card)
+		ring := univariate.DefRing(field)
+		for _, n := range [...]uint{1, 7, 11, 13, 15, 21, 31} {
+			if auxmath.Gcd(card, n) != 1 {
+				continue
+			}
+			factors, err := ring.CyclotomicFactors(n)
+			if err != nil {
+				t.Errorf("CyclotomicFactors(%d) over GF(%d) returned an "+
+					"error: %q", n, card, err)
+				continue
+			}
+
+			prod := ring.One()
+			for _, f := range factors {
+				prod.Mult(f.MinPoly)
+				if f.MinPoly.Ld() != len(f.Coset) || !f.MinPoly.IsIrreducible() {
+					t.Errorf("Factor %v labelled by %v over GF(%d) has wrong "+
+						"degree or is reducible", f.MinPoly, f.Coset, card)
+				}
+			}
+			xn := ring.Zero().SetCoef(int(n), field.One())
+			xn.DecrementCoef(0, field.One())
+			if !prod.Equal(xn) {
+				t.Errorf("Product of factors over GF(%d) was %v, but expected "+
+					"%v", card, prod, xn)
+			}
+
+			// The factor labelled by s has b^s as a root, where b is a root of
+			// the factor labelled by 1. That is, the minimal polynomial of b
+			// divides f(X^s)
+			if n == 1 {
+				continue
+			}
+			m1 := factors[1].MinPoly
+			for _, f := range factors {
+				xs := ring.Zero().SetCoef(int(f.Coset[0]), field.One())
+				if c := f.MinPoly.ModComposition(xs, m1); !c.IsZero() {
+					t.Errorf("Factor %v over GF(%d) is not labelled "+
+						"consistently by %v", f.MinPoly, card, f.Coset)
+				}
+			}
+
+			// The labelling must not change between calls
+			again, _ := ring.CyclotomicFactors(n)
+			for i, f := range again {
+				if !f.MinPoly.Equal(factors[i].MinPoly) {
+					t.Errorf("Coset %v over GF(%d) labelled %v and %v in "+
+						"repeated calls", f.Coset, card, factors[i].MinPoly,
+						f.MinPoly)
+				}
+			}
+		}
+	}
+}
+
+func TestCyclotomic(t *testing.T) {
+	field := defineField(7)
+	ring := univariate.DefRing(field)
+	tests := map[uint]string{
+		1:  "X + 6",
+		2:  "X + 1",
+		6:  "X^2 + 6X + 1",
+		12: "X^4 + 6X^2 + 1",
+		15: "X^8 + 6X^7 + X^5 + 6X^4 + X^3 + 6X + 1",
+	}
+	for n, expected := range tests {
+		if f := ring.Cyclotomic(n); f.String() != expected {
+			t.Errorf("Cyclotomic(%d) returned %v, but expected %s",
+				n, f, expected)
+		}
+	}
+
+	// The cyclotomic polynomial is the product of the factors labelled by
+	// cosets of elements coprime to n
+	for _, card := range [...]uint{2, 4, 5, 9} {
+		field := defineField(card)
+		ring := univariate.DefRing(field)
+		for _, n := range [...]uint{7, 15, 21} {
+			if auxmath.Gcd(card, n) != 1 {
+				continue
+			}
+			factors, err := ring.CyclotomicFactors(n)
+			if err != nil {
+				t.Errorf("CyclotomicFactors returned an error: %q", err)
+				continue
+			}
+			prod := ring.One()
+			for _, f := range factors {
+				if auxmath.Gcd(f.Coset[0], n) == 1 {
+					prod.Mult(f.MinPoly)
+				}
+			}
+			if c := ring.Cyclotomic(n); !c.Equal(prod) {
+				t.Errorf("Cyclotomic(%d) over GF(%d) returned %v, but "+
+					"expected %v", n, card, c, prod)
+			}
+		}
+	}
+
+	if f := ring.Cyclotomic(0); f.Err() == nil {
+		t.Errorf("Cyclotomic(0) returned no error")
+	} else if !errors.Is(errors.InputValue, f.Err()) {
+		t.Errorf("Cyclotomic returned an error of unexpected kind (err = %v)",
+			f.Err())
+	}
+}
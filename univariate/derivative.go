@@ -0,0 +1,117 @@
+package univariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// Derivative returns the formal derivative of f.
+//
+// Note that in positive characteristic p, the derivative of X^p is zero. See
+// also HasseDerivative.
+func (f *Polynomial) Derivative() *Polynomial {
+	const op = "Computing derivative"
+
+	if tmp := hasErr(op, f); tmp != nil {
+		return tmp
+	}
+
+	h := f.baseRing.fromDense(derivCoefs(f.BaseField(), f.Coefs()))
+	h.reduce()
+	return h
+}
+
+// HasseDerivative returns the k'th Hasse derivative of f. That is, the
+// polynomial
+//
+//	binom(n, k)*c_n*X^(n-k) + ... + binom(k, k)*c_k
+//
+// where c_i is the coefficient of degree i in f.
+//
+// Unlike the k'th formal derivative, the k'th Hasse derivative does not vanish
+// identically when k is at least the characteristic. Over a field of
+// characteristic zero, it would equal the k'th formal derivative divided by k!.
+//
+// If k is negative, the returned polynomial has an InputValue-error as error
+// status.
+func (f *Polynomial) HasseDerivative(k int) *Polynomial {
+	const op = "Computing Hasse derivative"
+
+	if tmp := hasErr(op, f); tmp != nil {
+		return tmp
+	}
+	if k < 0 {
+		out := f.baseRing.Zero()
+		out.err = errors.New(
+			op, errors.InputValue,
+			"Order of derivative must be non-negative (received %d)", k,
+		)
+		return out
+	}
+	if k > f.Ld() {
+		return f.baseRing.Zero()
+	}
+
+	coefs := make([]ff.Element, f.Ld()-k+1)
+	for i := range coefs {
+		coefs[i] = f.Coef(i + k).Mult(
+			binomial(f.BaseField(), uint(i+k), uint(k)),
+		)
+	}
+	h := f.baseRing.fromDense(coefs)
+	h.reduce()
+	return h
+}
+
+// TaylorShift returns the polynomial f(X+a). Equivalently, the coefficients of
+// the result are the coefficients of the expansion of f around a; that is, the
+// coefficient of degree k is the k'th Hasse derivative of f evaluated at a.
+func (f *Polynomial) TaylorShift(a ff.Element) *Polynomial {
+	const op = "Computing Taylor shift"
+
+	if tmp := hasErr(op, f); tmp != nil {
+		return tmp
+	}
+
+	// Use Horner's rule for computing f(X+a). In each step, the current
+	// polynomial is multiplied by X+a
+	coefs := zeroSlice(f.BaseField(), f.Ld()+1)
+	tmp := f.BaseField().Zero()
+	for i := f.Ld(); i >= 0; i-- {
+		// The current polynomial has degree at most f.Ld()-i-1 and is stored
+		// in coefs[i+1:]. Multiply by X+a and add f's coefficient
+		for j := i; j < f.Ld(); j++ {
+			coefs[j].Add(tmp.Prod(a, coefs[j+1]))
+		}
+		if !f.coefIsZero(i) {
+			coefs[i].Add(f.coefPtr(i))
+		}
+	}
+
+	h := f.baseRing.fromDense(coefs)
+	h.reduce()
+	return h
+}
+
+// binomial returns the binomial coefficient binom(n, k) as an element of the
+// given field. The value is computed using Lucas' theorem, such that only
+// binomial coefficients with entries less than the characteristic are needed.
+func binomial(field ff.Field, n, k uint) ff.Element {
+	p := field.Char()
+	out := field.One()
+	for k > 0 {
+		nd, kd := n%p, k%p
+		if kd > nd {
+			return field.Zero()
+		}
+		// Compute binom(nd, kd) as a product of fractions. The denominators
+		// are non-zero since they are less than p
+		for j := uint(0); j < kd; j++ {
+			out.Mult(field.ElementFromUnsigned(nd - j))
+			out.Mult(field.ElementFromUnsigned(j + 1).Inv())
+		}
+		n /= p
+		k /= p
+	}
+	return out
+}
@@ -0,0 +1,87 @@
+package univariate_test
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func TestDerivative(t *testing.T) {
+	field := defineField(5)
+	ring := univariate.DefRing(field)
+
+	f := ring.PolynomialFromUnsigned([]uint{1, 2, 3, 0, 0, 4, 1})
+	expected := ring.PolynomialFromUnsigned([]uint{2, 1, 0, 0, 0, 1})
+	if d := f.Derivative(); !d.Equal(expected) {
+		t.Errorf("Derivative of %v was %v, but expected %v", f, d, expected)
+	}
+
+	// The fifth Hasse derivative of X^6 is 6X = X, whereas the fifth
+	// derivative is zero
+	g := ring.PolynomialFromUnsigned([]uint{0, 0, 0, 0, 0, 0, 1})
+	expected = ring.PolynomialFromUnsigned([]uint{0, 1})
+	if h := g.HasseDerivative(5); !h.Equal(expected) {
+		t.Errorf("Fifth Hasse derivative of %v was %v, but expected %v",
+			g, h, expected)
+	}
+
+	if h := g.HasseDerivative(-1); h.Err() == nil {
+		t.Errorf("HasseDerivative succeeded for negative order")
+	} else if !errors.Is(errors.InputValue, h.Err()) {
+		t.Errorf("HasseDerivative returned an error of unexpected kind "+
+			"(err = %v)", h.Err())
+	}
+
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+		for rep := 0; rep < 20; rep++ {
+			f := randomPolynomial(ring, prg.Intn(20))
+
+			// k! times the k'th Hasse derivative is the k'th derivative
+			d := f.Copy()
+			fact := field.One()
+			for k := 0; k <= f.Ld()+1; k++ {
+				if k > 0 {
+					d = d.Derivative()
+					fact.Mult(field.ElementFromUnsigned(uint(k)))
+				}
+				if h := f.HasseDerivative(k).Scale(fact); !h.Equal(d) {
+					t.Errorf("%d'th Hasse derivative of %v does not agree "+
+						"with %d'th derivative %v", k, f, k, d)
+				}
+			}
+		}
+	}
+	fieldLoop(do)
+}
+
+func TestTaylorShift(t *testing.T) {
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+		for rep := 0; rep < 20; rep++ {
+			f := randomPolynomial(ring, prg.Intn(20))
+			a := field.RandElement()
+
+			g := f.TaylorShift(a)
+			for k := 0; k <= f.Ld(); k++ {
+				if c := f.HasseDerivative(k).Eval(a); !g.Coef(k).Equal(c) {
+					t.Errorf("Coefficient %d of %v is %v, but expected %v",
+						k, g, g.Coef(k), c)
+				}
+			}
+
+			x := field.RandElement()
+			if !g.Eval(x).Equal(f.Eval(x.Plus(a))) {
+				t.Errorf("TaylorShift(%v) of %v does not evaluate correctly",
+					a, f)
+			}
+
+			if h := g.TaylorShift(a.Neg()); !h.Equal(f) {
+				t.Errorf("Shifting %v by %v and back gave %v", f, a, h)
+			}
+		}
+	}
+	fieldLoop(do)
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/ReneBoedker/algobra/finitefield"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
 	"github.com/ReneBoedker/algobra/univariate"
 )
 
@@ -117,3 +118,127 @@ func ExampleQuotientRing_SetVarName() {
 	// f(Y) = Y^4 + (a + 1)Y^3 + a
 	// g(Y) = aY^3 + (a + 1)Y^2 + Y
 }
+
+func ExamplePolynomial_Inv() {
+	gf2, _ := finitefield.Define(2)
+	ring := univariate.DefRing(gf2)
+
+	// The quotient ring modulo the irreducible X^3 + X + 1 is a field
+	g, _ := ring.PolynomialFromString("X^3 + X + 1")
+	id, _ := ring.NewIdeal(g)
+	qRing, _ := ring.Quotient(id)
+
+	f, _ := qRing.PolynomialFromString("X^2 + 1")
+	fmt.Println(f.Inv())
+	// Output:
+	// X
+}
+
+func ExampleExtendedGcd() {
+	gf5, _ := finitefield.Define(5)
+	ring := univariate.DefRing(gf5)
+
+	f, _ := ring.PolynomialFromString("X^3 + 1")
+	g, _ := ring.PolynomialFromString("X^2 + 2X + 1")
+
+	d, s, t, _ := univariate.ExtendedGcd(f, g)
+	fmt.Printf("%v = (%v)(%v) + (%v)(%v)", d, s, f, t, g)
+	// Output:
+	// X + 1 = (2)(X^3 + 1) + (3X + 4)(X^2 + 2X + 1)
+}
+
+func ExampleInterpolator() {
+	gf7, _ := finitefield.Define(7)
+	ring := univariate.DefRing(gf7)
+
+	ip, _ := ring.NewInterpolator([]ff.Element{
+		gf7.ElementFromUnsigned(1),
+		gf7.ElementFromUnsigned(2),
+	})
+
+	// The precomputation is reused for each value vector
+	f, _ := ip.Interpolate([]ff.Element{gf7.One(), gf7.One()})
+	g, _ := ip.Interpolate([]ff.Element{gf7.Zero(), gf7.One()})
+	fmt.Printf("f(X) = %v\ng(X) = %v\n", f, g)
+
+	// Adding a point updates the precomputation
+	ip.AddPoint(gf7.ElementFromUnsigned(3))
+	h, _ := ip.Interpolate([]ff.Element{gf7.One(), gf7.ElementFromUnsigned(4), gf7.ElementFromUnsigned(2)})
+	fmt.Printf("h(X) = %v", h)
+	// Output:
+	// f(X) = 1
+	// g(X) = X + 6
+	// h(X) = X^2
+}
+
+func ExampleCRT() {
+	gf7, _ := finitefield.Define(7)
+	ring := univariate.DefRing(gf7)
+
+	// Find f such that f(1) = 2 and f(-1) = 4
+	f, err := univariate.CRT(
+		[]*univariate.Polynomial{
+			ring.PolynomialFromUnsigned([]uint{2}),
+			ring.PolynomialFromUnsigned([]uint{4}),
+		},
+		[]*univariate.Polynomial{
+			ring.PolynomialFromSigned([]int{-1, 1}),
+			ring.PolynomialFromSigned([]int{1, 1}),
+		},
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(f)
+	// Output:
+	// 6X + 3
+}
+
+func ExampleQuotientRing_BerlekampMassey() {
+	gf2, _ := finitefield.Define(2)
+	ring := univariate.DefRing(gf2)
+
+	// Output of the LFSR given by s_j = s_(j-1) + s_(j-3)
+	seq := make([]ff.Element, 0, 10)
+	for _, s := range []uint{1, 0, 0, 1, 1, 1, 0, 1, 0, 0} {
+		seq = append(seq, gf2.ElementFromUnsigned(s))
+	}
+
+	conn, l := ring.BerlekampMassey(seq)
+	fmt.Printf("Connection polynomial %v of linear complexity %d\n", conn, l)
+	// Output:
+	// Connection polynomial X^3 + X + 1 of linear complexity 3
+}
+
+func ExamplePadeApproximant() {
+	gf7, _ := finitefield.Define(7)
+	ring := univariate.DefRing(gf7)
+
+	// The power series of (1+X)/(1-2X) truncated at X^5
+	f := ring.PolynomialFromUnsigned([]uint{1, 3, 6, 5, 3})
+
+	a, b, err := univariate.PadeApproximant(f, 5, 1, 1)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("(%v)/(%v)\n", a, b)
+	// Output:
+	// (X + 1)/(5X + 1)
+}
+
+func ExampleQuotientRing_CyclotomicFactors() {
+	gf2, _ := finitefield.Define(2)
+	ring := univariate.DefRing(gf2)
+
+	factors, err := ring.CyclotomicFactors(7)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range factors {
+		fmt.Printf("Coset %v: %v\n", f.Coset, f.MinPoly)
+	}
+	// Output:
+	// Coset [0]: X + 1
+	// Coset [1 2 4]: X^3 + X + 1
+	// Coset [3 6 5]: X^3 + X^2 + 1
+}
@@ -0,0 +1,267 @@
+package univariate
+
+import (
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// karatsubaThreshold is the length of coefficient slices below which the
+// classical algorithms for multiplication and division are used.
+const karatsubaThreshold = 32
+
+// fromDense returns a polynomial over r with the given coefficients. The slice
+// is used directly as the underlying representation, and the result is not
+// reduced modulo the ideal of r.
+func (r *QuotientRing) fromDense(coefs []ff.Element) *Polynomial {
+	if len(coefs) == 0 {
+		return r.Zero()
+	}
+	f := &Polynomial{
+		baseRing: r,
+		coefs:    coefs,
+	}
+	f.reslice()
+	return f
+}
+
+// zeroSlice returns a slice of length n containing (distinct) zero elements.
+func zeroSlice(field ff.Field, n int) []ff.Element {
+	out := make([]ff.Element, n)
+	for i := range out {
+		out[i] = field.Zero()
+	}
+	return out
+}
+
+// mulCoefs returns the coefficients of the product of the polynomials with
+// coefficients a and b. The result has length len(a)+len(b)-1.
+//
+// Karatsuba's algorithm is used when both inputs are sufficiently long (see
+// [GG13; Section 8.1]).
+func mulCoefs(field ff.Field, a, b []ff.Element) []ff.Element {
+	if len(a) == 0 || len(b) == 0 {
+		return []ff.Element{}
+	}
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+
+	if len(b) < karatsubaThreshold {
+		return mulCoefsClassical(field, a, b)
+	}
+
+	m := len(a) / 2
+	if len(b) <= m {
+		// Unbalanced lengths. Split only a.
+		out := zeroSlice(field, len(a)+len(b)-1)
+		addCoefsTo(out, mulCoefs(field, a[:m], b), 0)
+		addCoefsTo(out, mulCoefs(field, a[m:], b), m)
+		return out
+	}
+
+	a0, a1 := a[:m], a[m:]
+	b0, b1 := b[:m], b[m:]
+
+	z0 := mulCoefs(field, a0, b0)
+	z2 := mulCoefs(field, a1, b1)
+	z1 := mulCoefs(field, sumCoefs(field, a0, a1), sumCoefs(field, b0, b1))
+	subCoefsFrom(z1, z0, 0)
+	subCoefsFrom(z1, z2, 0)
+
+	out := zeroSlice(field, len(a)+len(b)-1)
+	addCoefsTo(out, z0, 0)
+	addCoefsTo(out, z1, m)
+	addCoefsTo(out, z2, 2*m)
+	return out
+}
+
+// mulCoefsClassical returns the coefficients of the product of the polynomials
+// with coefficients a and b using the classical algorithm.
+func mulCoefsClassical(field ff.Field, a, b []ff.Element) []ff.Element {
+	out := zeroSlice(field, len(a)+len(b)-1)
+	tmp := field.Zero()
+	for i, ca := range a {
+		if ca.IsZero() {
+			continue
+		}
+		for j, cb := range b {
+			if cb.IsZero() {
+				continue
+			}
+			tmp.Prod(ca, cb)
+			out[i+j].Add(tmp)
+		}
+	}
+	return out
+}
+
+// sumCoefs returns the coefficients of the sum of the polynomials with
+// coefficients a and b.
+func sumCoefs(field ff.Field, a, b []ff.Element) []ff.Element {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	out := make([]ff.Element, len(a))
+	for i := range a {
+		out[i] = a[i].Copy()
+		if i < len(b) {
+			out[i].Add(b[i])
+		}
+	}
+	return out
+}
+
+// addCoefsTo adds the polynomial with coefficients b multiplied by X^shift to
+// the polynomial with coefficients a. The slice a must be sufficiently long.
+func addCoefsTo(a, b []ff.Element, shift int) {
+	for i, c := range b {
+		if i+shift >= len(a) {
+			// Remaining coefficients of b are zero by assumption
+			return
+		}
+		a[i+shift].Add(c)
+	}
+}
+
+// subCoefsFrom subtracts the polynomial with coefficients b multiplied by
+// X^shift from the polynomial with coefficients a. The slice a must be
+// sufficiently long.
+func subCoefsFrom(a, b []ff.Element, shift int) {
+	for i, c := range b {
+		if i+shift >= len(a) {
+			// Remaining coefficients of b are zero by assumption
+			return
+		}
+		a[i+shift].Sub(c)
+	}
+}
+
+// trimCoefs removes leading zeros from the coefficient slice a.
+func trimCoefs(a []ff.Element) []ff.Element {
+	for len(a) > 0 && a[len(a)-1].IsZero() {
+		a = a[:len(a)-1]
+	}
+	return a
+}
+
+// reverseCoefs returns the coefficients of X^(n-1)*a(1/X) where n is the given
+// length. The slice a is truncated or padded with zeros as needed.
+func reverseCoefs(field ff.Field, a []ff.Element, n int) []ff.Element {
+	out := make([]ff.Element, n)
+	for i := range out {
+		if j := n - 1 - i; j < len(a) {
+			out[i] = a[j].Copy()
+		} else {
+			out[i] = field.Zero()
+		}
+	}
+	return out
+}
+
+// invSeries computes the inverse of the power series with coefficients h
+// modulo X^n. The constant term of h must be non-zero.
+//
+// The implementation uses Newton iteration (see [GG13; Algorithm 9.3]).
+func invSeries(field ff.Field, h []ff.Element, n int) []ff.Element {
+	g := []ff.Element{h[0].Inv()}
+	two := field.ElementFromUnsigned(2)
+
+	for prec := 1; prec < n; {
+		prec *= 2
+		if prec > n {
+			prec = n
+		}
+		hTrunc := h
+		if len(hTrunc) > prec {
+			hTrunc = hTrunc[:prec]
+		}
+
+		// Compute g*(2-h*g) modulo X^prec
+		e := mulCoefs(field, hTrunc, g)
+		if len(e) > prec {
+			e = e[:prec]
+		}
+		for i := range e {
+			e[i].SetNeg()
+		}
+		e[0].Add(two)
+		g = mulCoefs(field, g, e)
+		if len(g) > prec {
+			g = g[:prec]
+		}
+	}
+	return g
+}
+
+// remCoefs computes the remainder of a modulo m. The output has length at most
+// deg(m). If mInv is non-nil, it must be the inverse of the reversal of m
+// modulo X^k for some k. When k is large enough, this is used for fast
+// division.
+//
+// The leading coefficient of m must be non-zero.
+func remCoefs(field ff.Field, a, m, mInv []ff.Element) []ff.Element {
+	a = trimCoefs(a)
+	d := len(m) - 1
+	if len(a) <= d {
+		return a
+	}
+	if d == 0 {
+		return []ff.Element{}
+	}
+
+	k := len(a) - d // Number of coefficients in the quotient
+	if d < karatsubaThreshold || k < karatsubaThreshold {
+		return remCoefsClassical(field, a, m)
+	}
+
+	if len(mInv) < k {
+		mInv = invSeries(field, reverseCoefs(field, m, len(m)), k)
+	}
+
+	// Compute the quotient using [GG13; Algorithm 9.5]
+	qRev := mulCoefs(field, reverseCoefs(field, a, len(a))[:k], mInv[:k])
+	q := reverseCoefs(field, qRev[:k], k)
+
+	qm := mulCoefs(field, q, m)
+	out := make([]ff.Element, d)
+	for i := range out {
+		out[i] = a[i].Minus(qm[i])
+	}
+	return out
+}
+
+// remCoefsClassical computes the remainder of a modulo m using the classical
+// algorithm. The output has length deg(m).
+func remCoefsClassical(field ff.Field, a, m []ff.Element) []ff.Element {
+	d := len(m) - 1
+	r := make([]ff.Element, len(a))
+	for i, c := range a {
+		r[i] = c.Copy()
+	}
+
+	lcInv := m[d].Inv()
+	tmp := field.Zero()
+	for i := len(r) - 1; i >= d; i-- {
+		if r[i].IsZero() {
+			continue
+		}
+		q := r[i].Times(lcInv)
+		for j := 0; j <= d; j++ {
+			tmp.Prod(q, m[j])
+			r[i-d+j].Sub(tmp)
+		}
+	}
+	return r[:d]
+}
+
+// derivCoefs returns the coefficients of the formal derivative of the
+// polynomial with coefficients a.
+func derivCoefs(field ff.Field, a []ff.Element) []ff.Element {
+	if len(a) <= 1 {
+		return []ff.Element{}
+	}
+	out := make([]ff.Element, len(a)-1)
+	for i := range out {
+		out[i] = a[i+1].Times(field.ElementFromUnsigned(uint(i + 1)))
+	}
+	return out
+}
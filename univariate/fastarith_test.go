@@ -0,0 +1,40 @@
+package univariate_test
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func TestTimesLarge(t *testing.T) {
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+		for rep := 0; rep < 5; rep++ {
+			fCoefs := make([]ff.Element, 40+prg.Intn(100))
+			for i := range fCoefs {
+				fCoefs[i] = field.RandElement()
+			}
+			gCoefs := make([]ff.Element, 40+prg.Intn(100))
+			for i := range gCoefs {
+				gCoefs[i] = field.RandElement()
+			}
+			f := ring.Polynomial(fCoefs)
+			g := ring.Polynomial(gCoefs)
+			h := f.Times(g)
+
+			if h.Ld() != f.Ld()+g.Ld() {
+				t.Errorf("deg(fg) = %d, but expected deg(f)+deg(g) = %d",
+					h.Ld(), f.Ld()+g.Ld())
+			}
+
+			for i := 0; i < 10; i++ {
+				p := field.RandElement()
+				if !h.Eval(p).Equal(f.Eval(p).Times(g.Eval(p))) {
+					t.Errorf("(fg)(%v) differs from f(%[1]v)*g(%[1]v)", p)
+				}
+			}
+		}
+	}
+	fieldLoop(do)
+}
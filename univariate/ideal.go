@@ -93,6 +93,94 @@ func Gcd(f *Polynomial, g ...*Polynomial) (*Polynomial, error) {
 	return r0, nil
 }
 
+// ExtendedGcd returns the greatest common divisor d of f and g together with
+// polynomials s and t such that d = s*f + t*g. The greatest common divisor is
+// normalized unless both f and g are zero.
+//
+// If f and g are defined over a quotient ring, the identity d = s*f + t*g holds
+// modulo the ideal.
+//
+// An InputIncompatible-error is returned if the polynomials are not defined
+// over the same ring.
+func ExtendedGcd(f, g *Polynomial) (d, s, t *Polynomial, err error) {
+	const op = "Computing extended polynomial GCD"
+
+	if f.baseRing != g.baseRing {
+		return nil, nil, nil, errors.New(
+			op, errors.InputIncompatible,
+			"Polynomials defined over different rings",
+		)
+	}
+
+	if tmp := hasErr(op, f, g); tmp != nil {
+		return nil, nil, nil, tmp.Err()
+	}
+
+	rs, ss, ts, err := euclid(f, g, 0)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	if rs[0].IsZero() {
+		return rs[0], ss[0], ts[0], nil
+	}
+
+	lcInv := rs[0].lcPtr().Inv()
+	return rs[0].SetScale(lcInv), ss[0].SetScale(lcInv), ts[0].SetScale(lcInv), nil
+}
+
+// PartialExtendedGcd runs the extended Euclidean algorithm on f and g until
+// the remainder has degree less than degBound. It returns this remainder r
+// together with polynomials s and t such that r = s*f + t*g. In contrast to
+// ExtendedGcd, the results are not normalized.
+//
+// Stopping the algorithm early is the key step of rational reconstruction and
+// of decoding Reed–Solomon codes. If degBound is not positive, the algorithm
+// runs to completion, and r is zero.
+//
+// An InputIncompatible-error is returned if the polynomials are not defined
+// over the same ring.
+func PartialExtendedGcd(f, g *Polynomial, degBound int) (r, s, t *Polynomial, err error) {
+	const op = "Computing partial extended polynomial GCD"
+
+	if f.baseRing != g.baseRing {
+		return nil, nil, nil, errors.New(
+			op, errors.InputIncompatible,
+			"Polynomials defined over different rings",
+		)
+	}
+
+	if tmp := hasErr(op, f, g); tmp != nil {
+		return nil, nil, nil, tmp.Err()
+	}
+
+	rs, ss, ts, err := euclid(f, g, degBound)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	return rs[1], ss[1], ts[1], nil
+}
+
+// euclid runs the extended Euclidean algorithm on f and g until the remainder
+// has degree less than degBound (see [GG13; Algorithm 3.14]). It returns the
+// last two rows, where each row satisfies r[i] = s[i]*f + t[i]*g.
+func euclid(f, g *Polynomial, degBound int) (r, s, t [2]*Polynomial, err error) {
+	r = [2]*Polynomial{f.Copy(), g.Copy()}
+	s = [2]*Polynomial{f.baseRing.One(), f.baseRing.Zero()}
+	t = [2]*Polynomial{f.baseRing.Zero(), f.baseRing.One()}
+
+	for r[1].IsNonzero() && r[1].Ld() >= degBound {
+		quo, rem, err := r[0].QuoRem(r[1])
+		if err != nil {
+			return r, s, t, err
+		}
+		r[0], r[1] = r[1], rem
+		s[0], s[1] = s[1], s[0].Sub(quo[0].Times(s[1]))
+		t[0], t[1] = t[1], t[0].Sub(quo[0].Times(t[1]))
+	}
+	return r, s, t, nil
+}
+
 // Copy creates a copy of id.
 func (id *Ideal) Copy() *Ideal {
 	return &Ideal{
@@ -113,7 +201,7 @@ func (id *Ideal) Reduce(f *Polynomial) error {
 		f.subWithShiftAndScale(
 			id.generator,
 			d-id.generator.Ld(),
-			f.coefs[f.Ld()], // Note that id.generator is normalized
+			f.lcPtr(), // Note that id.generator is normalized
 		)
 	}
 	return nil
@@ -1,14 +1,16 @@
 package univariate
 
 import (
-	"github.com/ReneBoedker/algobra/auxmath"
 	"github.com/ReneBoedker/algobra/errors"
 	"github.com/ReneBoedker/algobra/finitefield/ff"
 )
 
 // Interpolate computes the Lagrange interpolation polynomial evaluating to
 // values in the specified points. The resulting polynomial has degree at most
-// len(points).
+// len(points)-1.
+//
+// The computation uses a subproduct tree, so the interpolation is
+// asymptotically fast even for a large number of points.
 //
 // It returns an InputValue-error if the number of points and values differ, or
 // if points are not distinct.
@@ -26,6 +28,31 @@ func (r *QuotientRing) Interpolate(
 		)
 	}
 
+	ip, err := r.NewInterpolator(points)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	return ip.Interpolate(values)
+}
+
+// Interpolator computes interpolation polynomials for a fixed set of points.
+//
+// The barycentric weights of the points are computed once, so subsequent
+// interpolations only require computations that depend on the values.
+type Interpolator struct {
+	ring    *QuotientRing
+	points  []ff.Element
+	weights []ff.Element
+	seen    map[string]struct{}
+	tree    *subproductTree
+}
+
+// NewInterpolator returns an Interpolator for the given points over r.
+//
+// It returns an InputValue-error if the points are not distinct.
+func (r *QuotientRing) NewInterpolator(points []ff.Element) (*Interpolator, error) {
+	const op = "Defining interpolator"
+
 	if !allDistinct(points) {
 		return nil, errors.New(
 			op, errors.InputValue,
@@ -33,139 +60,135 @@ func (r *QuotientRing) Interpolate(
 		)
 	}
 
-	f := r.zeroWithCap(len(points))
+	ip := &Interpolator{
+		ring:    r,
+		points:  make([]ff.Element, len(points)),
+		weights: make([]ff.Element, len(points)),
+		seen:    make(map[string]struct{}, len(points)),
+	}
 	for i, p := range points {
-		if values[i].IsZero() {
-			// No need to compute the Lagrange basis polynomial since we will
-			// scale it by zero anyway
-			continue
-		}
-		f.Add(r.lagrangeBasis(points, p).Scale(values[i]))
+		ip.points[i] = p.Copy()
+		ip.seen[p.String()] = struct{}{}
+	}
+
+	if len(points) == 0 {
+		return ip, nil
+	}
+
+	// The weights are 1/m'(u_i), where m is the product of the linear factors
+	// X-u_i (see [GG13; Algorithm 10.11])
+	ip.tree = newSubproductTree(r.baseField, ip.points)
+	for i, e := range ip.tree.evaluate(derivCoefs(r.baseField, ip.tree.root())) {
+		ip.weights[i] = e.Inv()
 	}
-	return f, f.Err()
+	return ip, nil
 }
 
-// allDistinct checks if given points are all distinct
-func allDistinct(points []ff.Element) bool {
-	unique := make(map[string]struct{})
-	for _, p := range points {
-		if _, ok := unique[p.String()]; ok {
-			return false
-		}
-		unique[p.String()] = struct{}{}
+// Points returns a copy of the interpolation points of ip.
+func (ip *Interpolator) Points() []ff.Element {
+	out := make([]ff.Element, len(ip.points))
+	for i, p := range ip.points {
+		out[i] = p.Copy()
 	}
-	return true
+	return out
 }
 
-// lagrangeBasis computes a "lagrange-type" basis element. That is, it computes
-// a polynomial that evaluates to 1 in point at index and to 0 in any other
-// point of points.
-// func (r *QuotientRing) lagrangeBasis(points []ff.Element, index int) *Polynomial {
-// 	f := r.PolynomialFromUnsigned([]uint{1})
+// VanishingPolynomial returns the product of X-u over the interpolation points
+// u of ip. This is the monic polynomial of least degree that vanishes in all the
+// points.
+//
+// The polynomial is read off the root of the subproduct tree of ip, so no
+// further multiplications are needed.
+func (ip *Interpolator) VanishingPolynomial() *Polynomial {
+	if ip.tree == nil {
+		return ip.ring.One()
+	}
+	root := ip.tree.root()
+	coefs := make([]ff.Element, len(root))
+	for i, c := range root {
+		coefs[i] = c.Copy()
+	}
+	f := ip.ring.fromDense(coefs)
+	f.reduce()
+	return f
+}
 
-// 	for i, p := range points {
-// 		if i == index {
-// 			continue
-// 		}
+// AddPoint adds point to the interpolation points of ip. The barycentric
+// weights of the existing points are updated rather than recomputed, and the
+// subproduct tree is extended by updating only the nodes on the path from the
+// new leaf to the root.
+//
+// It returns an InputValue-error if point is already an interpolation point.
+func (ip *Interpolator) AddPoint(point ff.Element) error {
+	const op = "Adding interpolation point"
 
-// 		f.Mult(r.Polynomial([]ff.Element{
-// 			p.Neg(),
-// 			r.baseField.One(),
-// 		})).Scale(points[index].Minus(p).Inv())
-// 	}
+	if _, ok := ip.seen[point.String()]; ok {
+		return errors.New(
+			op, errors.InputValue,
+			"%v is already an interpolation point", point,
+		)
+	}
 
-// 	return f
-// }
+	w := ip.ring.baseField.One()
+	for i, p := range ip.points {
+		diff := p.Minus(point)
+		ip.weights[i].Mult(diff.Inv())
+		w.Mult(diff.SetNeg())
+	}
 
-func (r *QuotientRing) lagrangeBasis(
-	points []ff.Element,
-	ignore ff.Element,
-) *Polynomial {
-	f := r.zeroWithCap(len(points))
-	denom := r.baseField.One()
+	ip.points = append(ip.points, point.Copy())
+	ip.weights = append(ip.weights, w.Inv())
+	ip.seen[point.String()] = struct{}{}
 
-	// Find the index of ignore-element
-	ignoreIndex := 0
-	for i, p := range points {
-		if p.Equal(ignore) {
-			ignoreIndex = i
-		}
+	if ip.tree == nil {
+		ip.tree = newSubproductTree(ip.ring.baseField, ip.points)
+	} else {
+		ip.tree.addPoint(point)
 	}
+	return nil
+}
+
+// Interpolate computes the Lagrange interpolation polynomial evaluating to
+// values in the points of ip. The resulting polynomial has degree at most
+// len(values)-1.
+//
+// It returns an InputValue-error if the number of points and values differ.
+func (ip *Interpolator) Interpolate(values []ff.Element) (*Polynomial, error) {
+	const op = "Computing interpolation"
 
-	// Compute the coefficients directly
-	for k := 0; k < len(points); k++ {
-		f.SetCoef(
-			k,
-			r.coefK(points, ignoreIndex, k),
+	if len(ip.points) != len(values) {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Different number of interpolation points and values (%d and %d)",
+			len(ip.points), len(values),
 		)
 	}
 
-	// Compute the denominator
-	for i, p := range points {
-		if i == ignoreIndex {
-			continue
-		}
-		denom.Mult(ignore.Minus(p))
+	if len(values) == 0 {
+		return ip.ring.Zero(), nil
 	}
 
-	f.SetScale(denom.Inv())
-	return f
+	c := make([]ff.Element, len(values))
+	for i := range c {
+		c[i] = values[i].Times(ip.weights[i])
+	}
+
+	f := ip.ring.fromDense(ip.tree.linearCombination(c))
+	f.reduce()
+	if f.Err() != nil {
+		return f, errors.Wrap(op, errors.Inherit, f.Err())
+	}
+	return f, nil
 }
 
-// coefK computes the coefficient of X^k or Y^k in the numerator of a Lagrange
-// basis polynomial. Such polynomials have the form (X-p_1)(X-p_2)...(X-p_n),
-// where we skip the p_i corresponding to ignore.
-func (r *QuotientRing) coefK(points []ff.Element, ignore, k int) ff.Element {
-	out := r.baseField.Zero()
-	tmp := r.baseField.Zero()
-
-	// Pick the X-term from k factors. This implies that the constant term in
-	// chosen from len(points)-1-k
-	chosen := len(points) - 1 - k
-outer:
-	for ci := auxmath.NewCombinIter(len(points), chosen); ci.Active(); ci.Next() {
-		tmp.SetUnsigned(1)
-
-		for _, i := range ci.Current() {
-			if i == ignore {
-				continue outer
-			}
-			tmp.Mult(points[i])
+// allDistinct checks if given points are all distinct
+func allDistinct(points []ff.Element) bool {
+	unique := make(map[string]struct{})
+	for _, p := range points {
+		if _, ok := unique[p.String()]; ok {
+			return false
 		}
-
-		out.Add(tmp)
-	}
-	if chosen%2 != 0 {
-		// (-1)^chosen == -1
-		out.SetNeg()
+		unique[p.String()] = struct{}{}
 	}
-	return out
+	return true
 }
-
-/* Copyright 2019 René Bødker Christensen
- *
- * Redistribution and use in source and binary forms, with or without
- * modification, are permitted provided that the following conditions are met:
- *
- * 1. Redistributions of source code must retain the above copyright notice, this
- *    list of conditions and the following disclaimer.
- *
- * 2. Redistributions in binary form must reproduce the above copyright notice,
- *    this list of conditions and the following disclaimer in the documentation
- *    and/or other materials provided with the distribution.
- *
- * 3. Neither the name of the copyright holder nor the names of its contributors
- *    may be used to endorse or promote products derived from this software
- *    without specific prior written permission.
- *
- * THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
- * AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
- * IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
- * DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
- * FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
- * DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
- * SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
- * CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
- * OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
- * OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
- */
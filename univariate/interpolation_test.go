@@ -8,60 +8,6 @@ import (
 	"github.com/ReneBoedker/algobra/univariate"
 )
 
-func TestLagrangeBasis(t *testing.T) {
-	//t.Fatalf("Skipped")
-	do := func(field ff.Field) {
-		ring := univariate.DefRing(field)
-
-	outer:
-		for rep := 0; rep < 25; rep++ {
-			const nPoints = 4
-			points := make([]ff.Element, nPoints, nPoints)
-
-			nRuns := 0
-			for nRuns == 0 || !univariate.AllDistinct(points) {
-				for j := 0; j < nPoints; j++ {
-					points[j] = field.RandElement()
-				}
-				nRuns++
-				if nRuns >= 100 {
-					t.Log("Skipping generation after 100 attempts")
-					continue outer
-				}
-			}
-
-			for j := range points {
-				// f evaluates to 1 in points[j] and 0 in other components of 0
-				f := ring.LagrangeBasis(points, points[j])
-
-				if f.IsZero() {
-					t.Errorf(
-						"GF(%d): Lagrange basis is zero with points %v and index %d",
-						field.Card(), points, j,
-					)
-				} else if ld := f.Ld(); ld > (nPoints - 1) {
-					t.Errorf(
-						"GF(%d): Lagrange basis has too large total degree (%d) with point %v",
-						field.Card(), ld, points[j],
-					)
-				}
-
-				for k, p := range points {
-					ev := f.Eval(p)
-					switch {
-					case j == k && !ev.IsOne():
-						t.Errorf("GF(%d): f(%v)=%v instead of 1 with f = %v", field.Card(), p, ev, f)
-					case j != k && ev.IsNonzero():
-						t.Errorf("GF(%d): f(%v)=%v instead of 0 with f = %v", field.Card(), p, ev, f)
-					}
-				}
-			}
-		}
-	}
-
-	fieldLoop(do, 4)
-}
-
 func TestInterpolation(t *testing.T) {
 	field := defineField(23)
 	ring := univariate.DefRing(field)
@@ -144,3 +90,167 @@ func TestInterpolationErrors(t *testing.T) {
 		)
 	}
 }
+
+// distinctPoints returns n distinct elements of field in random order.
+func distinctPoints(field ff.Field, n int) []ff.Element {
+	elems := field.Elements()
+	prg.Shuffle(len(elems), func(i, j int) {
+		elems[i], elems[j] = elems[j], elems[i]
+	})
+	return elems[:n]
+}
+
+func TestEvalMulti(t *testing.T) {
+	for _, card := range []uint{2, 256, 1031} {
+		field := defineField(card)
+		ring := univariate.DefRing(field)
+
+		for _, n := range []int{0, 5, 100, 300} {
+			coefs := make([]ff.Element, n+prg.Intn(50))
+			for i := range coefs {
+				coefs[i] = field.RandElement()
+			}
+			f := ring.Polynomial(coefs)
+
+			// The points need not be distinct
+			points := make([]ff.Element, n)
+			for i := range points {
+				points[i] = field.RandElement()
+			}
+
+			values := f.EvalMulti(points)
+			if len(values) != n {
+				t.Errorf("EvalMulti returned %d values, but expected %d",
+					len(values), n)
+				continue
+			}
+			for i, p := range points {
+				if ev := f.Eval(p); !ev.Equal(values[i]) {
+					t.Errorf("GF(%d): EvalMulti gave f(%v) = %v, but Eval "+
+						"gave %v", card, p, values[i], ev)
+				}
+			}
+		}
+	}
+}
+
+func TestInterpolationLarge(t *testing.T) {
+	for _, card := range []uint{256, 1031} {
+		field := defineField(card)
+		ring := univariate.DefRing(field)
+
+		for _, n := range []int{1, 33, 250} {
+			points := distinctPoints(field, n)
+			values := make([]ff.Element, n)
+			for i := range values {
+				values[i] = field.RandElement()
+			}
+
+			f, err := ring.Interpolate(points, values)
+			if err != nil {
+				t.Errorf("Interpolation returned error: %q", err)
+				continue
+			}
+			if f.Ld() >= n {
+				t.Errorf("GF(%d): Interpolation polynomial through %d points "+
+					"has degree %d", card, n, f.Ld())
+			}
+			for i, ev := range f.EvalMulti(points) {
+				if !ev.Equal(values[i]) {
+					t.Errorf("GF(%d): f(%v) = %v, but expected %v",
+						card, points[i], ev, values[i])
+				}
+			}
+		}
+	}
+}
+
+func TestInterpolator(t *testing.T) {
+	field := defineField(257)
+	ring := univariate.DefRing(field)
+
+	points := distinctPoints(field, 60)
+	ip, err := ring.NewInterpolator(points[:50])
+	if err != nil {
+		t.Fatalf("NewInterpolator returned error: %q", err)
+	}
+
+	check := func(n int) {
+		for rep := 0; rep < 3; rep++ {
+			values := make([]ff.Element, n)
+			for i := range values {
+				values[i] = field.RandElement()
+			}
+
+			f, err := ip.Interpolate(values)
+			if err != nil {
+				t.Errorf("Interpolate returned error: %q", err)
+				return
+			}
+			for i, ev := range f.EvalMulti(points[:n]) {
+				if !ev.Equal(values[i]) {
+					t.Errorf("%d points: f(%v) = %v, but expected %v",
+						n, points[i], ev, values[i])
+				}
+			}
+		}
+	}
+
+	check(50)
+	for n := 51; n <= 60; n++ {
+		// The subtree of the first 32 points is not on the path of the new
+		// leaf, so it must be kept rather than recomputed
+		kept := ip.TreeNode(5, 0)
+		if err := ip.AddPoint(points[n-1]); err != nil {
+			t.Errorf("AddPoint returned error: %q", err)
+		}
+		if node := ip.TreeNode(5, 0); &node[0] != &kept[0] {
+			t.Errorf("AddPoint recomputed the subproduct tree")
+		}
+
+		// The root must be the product of all linear factors
+		root := ip.VanishingPolynomial()
+		prod := ring.One()
+		for _, p := range points[:n] {
+			prod.Mult(ring.Polynomial([]ff.Element{p.Neg(), field.One()}))
+		}
+		if !root.Equal(prod) {
+			t.Errorf("Vanishing polynomial of %d points was %v, but "+
+				"expected %v", n, root, prod)
+		}
+
+		// Modifying the vanishing polynomial must not affect the tree
+		root.SetCoef(0, field.Zero())
+		if !ip.VanishingPolynomial().Equal(prod) {
+			t.Errorf("Modifying the vanishing polynomial changed the " +
+				"subproduct tree")
+		}
+		check(n)
+	}
+
+	if err := ip.AddPoint(points[0]); err == nil {
+		t.Errorf("AddPoint did not return an error for a duplicate point")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("AddPoint returned error of unexpected kind (err = %v)", err)
+	}
+
+	if _, err := ip.Interpolate(make([]ff.Element, 3)); err == nil {
+		t.Errorf("Interpolate did not return an error for wrong number of " +
+			"values")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("Interpolate returned error of unexpected kind (err = %v)",
+			err)
+	}
+
+	// Starting from no points at all
+	ip, _ = ring.NewInterpolator(nil)
+	if f := ip.VanishingPolynomial(); !f.IsOne() {
+		t.Errorf("Vanishing polynomial of no points was %v, but expected 1", f)
+	}
+	for n := 1; n <= 5; n++ {
+		if err := ip.AddPoint(points[n-1]); err != nil {
+			t.Errorf("AddPoint returned error: %q", err)
+		}
+		check(n)
+	}
+}
@@ -0,0 +1,72 @@
+package univariate
+
+import (
+	"github.com/ReneBoedker/algobra/auxmath"
+)
+
+// IsIrreducible returns a boolean describing whether f is irreducible over its
+// base field. Constant polynomials are not considered irreducible.
+//
+// If f is defined over a quotient ring, the test is performed on f itself
+// rather than on its residue class.
+func (f *Polynomial) IsIrreducible() bool {
+	// The implementation is based on Rabin's test (see for instance
+	// [GG13; Section 14.9])
+	if f.err != nil || f.IsZero() || f.Ld() < 1 {
+		return false
+	}
+	n := uint(f.Ld())
+	if n == 1 {
+		return true
+	}
+
+	// The degrees k for which gcd(X^(q^k)-X, f) must be one
+	checks := make(map[uint]struct{})
+	primes, _ := auxmath.Factorize(n)
+	for _, p := range primes {
+		checks[n/p] = struct{}{}
+	}
+
+	x := f.baseRing.zeroWithCap(2)
+	x.SetCoefPtr(1, f.BaseField().One())
+
+	// The Frobenius powers X^(q^k) modulo f are computed by composing with
+	// X^q, reusing the baby steps of the modular composition
+	frob := x.powMod(f.BaseField().Card(), f)
+	composer := newModComposer(f.BaseField(), frob.Coefs(), f.Coefs())
+
+	h := frob.Copy()
+	for k := uint(1); k <= n; k++ {
+		if k > 1 {
+			h = f.baseRing.fromDense(composer.compose(h.Coefs()))
+		}
+		if _, ok := checks[k]; !ok {
+			continue
+		}
+		// Ignore error since all polynomials are defined over the same ring
+		g, _ := Gcd(f, h.Minus(x))
+		if g.Ld() > 0 {
+			return false
+		}
+	}
+	return h.Equal(x)
+}
+
+// powMod returns f^n modulo m. Neither the intermediate results nor the result
+// are reduced modulo the ideal of the ring, so m need not be a member of the
+// ideal.
+func (f *Polynomial) powMod(n uint, m *Polynomial) *Polynomial {
+	out := f.baseRing.One()
+	_, g, _ := f.QuoRem(m) // Ignore error since f and m are compatible
+
+	for n > 0 {
+		if n%2 == 1 {
+			_, out, _ = out.multNoReduce(g).QuoRem(m)
+		}
+		n /= 2
+		if n > 0 {
+			_, g, _ = g.multNoReduce(g).QuoRem(m)
+		}
+	}
+	return out
+}
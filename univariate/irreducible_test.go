@@ -0,0 +1,55 @@
+package univariate_test
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func TestIsIrreducible(t *testing.T) {
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+		elems := field.Elements()
+
+		for rep := 0; rep < 20; rep++ {
+			// For degrees two and three, irreducibility is equivalent to having
+			// no roots
+			coefs := make([]ff.Element, prg.Intn(2)+3)
+			for i := range coefs {
+				coefs[i] = field.RandElement()
+			}
+			coefs[len(coefs)-1] = field.One()
+			f := ring.Polynomial(coefs)
+
+			hasRoot := false
+			for _, e := range elems {
+				if f.Eval(e).IsZero() {
+					hasRoot = true
+					break
+				}
+			}
+			if f.IsIrreducible() == hasRoot {
+				t.Errorf(
+					"IsIrreducible returned %t for %v over GF(%d)",
+					!hasRoot, f, field.Card(),
+				)
+			}
+		}
+	}
+
+	fieldLoop(do)
+
+	field := defineField(2)
+	ring := univariate.DefRing(field)
+	// (X^2+X+1)^2 has no roots over GF(2), but it is reducible
+	if f := ring.PolynomialFromUnsigned([]uint{1, 0, 1, 0, 1}); f.IsIrreducible() {
+		t.Errorf("IsIrreducible returned true for %v", f)
+	}
+	if f := ring.PolynomialFromUnsigned([]uint{1, 1, 0, 0, 1}); !f.IsIrreducible() {
+		t.Errorf("IsIrreducible returned false for %v", f)
+	}
+	if ring.One().IsIrreducible() || ring.Zero().IsIrreducible() {
+		t.Errorf("IsIrreducible returned true for a constant polynomial")
+	}
+}
@@ -0,0 +1,168 @@
+package univariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// BerlekampMassey computes the shortest linear recurrence generating the given
+// sequence. The elements of seq must belong to the base field of r.
+//
+// The recurrence is returned as the connection polynomial
+//
+//	C(X) = 1 + c_1*X + ... + c_L*X^L
+//
+// together with the linear complexity L. That is, the sequence satisfies
+//
+//	seq[j] + c_1*seq[j-1] + ... + c_L*seq[j-L] = 0
+//
+// for all j with L <= j < len(seq). Note that the degree of C may be smaller
+// than L.
+//
+// If any of the elements has a non-nil error status, the returned polynomial
+// has an error status with kind Inherit.
+func (r *QuotientRing) BerlekampMassey(seq []ff.Element) (conn *Polynomial, complexity int) {
+	// The implementation is based on Massey's formulation of the algorithm
+	// (see J. L. Massey, Shift-register synthesis and BCH decoding, 1969)
+	const op = "Computing shortest linear recurrence"
+
+	for _, s := range seq {
+		if s.Err() != nil {
+			conn = r.Zero()
+			conn.err = errors.Wrap(op, errors.Inherit, s.Err())
+			return conn, 0
+		}
+	}
+
+	conn = r.One()
+	prev := r.One()
+	prevDiscr := r.baseField.One()
+	shift := 1
+
+	discr := r.baseField.Zero()
+	tmp := r.baseField.Zero()
+	for n := range seq {
+		// Compute the discrepancy of conn at position n
+		discr.SetUnsigned(0)
+		conn.forEachTerm(func(i int, c ff.Element) {
+			if i <= n {
+				discr.Add(tmp.Prod(c, seq[n-i]))
+			}
+		})
+
+		if discr.IsZero() {
+			shift++
+			continue
+		}
+
+		scale := discr.Times(prevDiscr.Inv())
+		if 2*complexity <= n {
+			old := conn.Copy()
+			conn.subWithShiftAndScale(prev, shift, scale)
+			complexity = n + 1 - complexity
+			prev = old
+			prevDiscr = discr.Copy()
+			shift = 1
+		} else {
+			conn.subWithShiftAndScale(prev, shift, scale)
+			shift++
+		}
+	}
+
+	conn.reduce()
+	return conn, complexity
+}
+
+// MinimalPolynomial returns the minimal polynomial of a over the prime subfield
+// of its field. The element a must belong to an extension of the base field of
+// r. In particular, r may be defined over the field of a itself or over its
+// prime subfield.
+//
+// The minimal polynomial is computed as the product of X-b where b runs through
+// the distinct conjugates of a.
+//
+// If a has a non-nil error status, the returned polynomial has an error status
+// with kind Inherit. If a cannot belong to an extension of the base field of r,
+// the returned polynomial has an InputIncompatible-error as error status.
+func (r *QuotientRing) MinimalPolynomial(a ff.Element) *Polynomial {
+	const op = "Computing minimal polynomial"
+
+	if a.Err() != nil {
+		out := r.Zero()
+		out.err = errors.Wrap(op, errors.Inherit, a.Err())
+		return out
+	}
+
+	incompatible := func() *Polynomial {
+		out := r.Zero()
+		out.err = errors.New(
+			op, errors.InputIncompatible,
+			"%v does not belong to an extension of %v", a, r.baseField,
+		)
+		return out
+	}
+
+	// Multiply the linear factors X-b. The coefficients are stored in the
+	// field of a
+	coefs := []ff.Element{a.Copy().SetUnsigned(1)}
+	tmp := a.Copy()
+	b := a.Copy()
+	for k := 0; k == 0 || !b.Equal(a); k++ {
+		// An extension of degree m has at least 2^m elements
+		if k >= 64 {
+			return incompatible()
+		}
+		next := make([]ff.Element, len(coefs)+1)
+		next[len(coefs)] = coefs[len(coefs)-1].Copy()
+		for i := len(coefs) - 1; i > 0; i-- {
+			next[i] = coefs[i-1].Minus(tmp.Prod(b, coefs[i]))
+		}
+		next[0] = tmp.Prod(b, coefs[0]).Neg()
+		coefs = next
+		b = b.Pow(r.baseField.Char())
+	}
+
+	// Move the coefficients to the base field of r through their values in the
+	// prime subfield
+	out := make([]ff.Element, len(coefs))
+	for i, c := range coefs {
+		val, ok := primeSubfieldValue(c, r.baseField.Char())
+		if !ok {
+			return incompatible()
+		}
+		out[i] = r.baseField.ElementFromUnsigned(val)
+	}
+	return r.Polynomial(out)
+}
+
+// primeSubfieldValue returns the integer k such that a is equal to k times the
+// identity, and a boolean describing whether a belongs to the prime subfield.
+//
+// Elements of prime fields are converted directly, and elements of extensions
+// are expanded over their base field. Otherwise, a is compared to the multiples
+// of the identity.
+func primeSubfieldValue(a ff.Element, char uint) (uint, bool) {
+	switch e := a.(type) {
+	case interface{ Uint() uint }:
+		return e.Uint(), true
+	case interface{ AsSlice() []ff.Element }:
+		coefs := e.AsSlice()
+		if len(coefs) == 0 {
+			return 0, true
+		}
+		for _, c := range coefs[1:] {
+			if c.IsNonzero() {
+				return 0, false
+			}
+		}
+		return primeSubfieldValue(coefs[0], char)
+	}
+
+	tmp := a.Copy()
+	for k := uint(0); k < char; k++ {
+		if tmp.SetUnsigned(k).Equal(a) {
+			return k, true
+		}
+	}
+	return 0, false
+}
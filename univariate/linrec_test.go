@@ -0,0 +1,107 @@
+package univariate_test
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func TestBerlekampMassey(t *testing.T) {
+	// Fixed LFSR over GF(2) given by s_j = s_(j-1) + s_(j-3)
+	gf2 := defineField(2)
+	ring := univariate.DefRing(gf2)
+	seq := []ff.Element{gf2.One(), gf2.Zero(), gf2.Zero()}
+	for j := 3; j < 14; j++ {
+		seq = append(seq, seq[j-1].Plus(seq[j-3]))
+	}
+	conn, l := ring.BerlekampMassey(seq)
+	if expected := ring.PolynomialFromUnsigned([]uint{1, 1, 0, 1}); !conn.Equal(expected) || l != 3 {
+		t.Errorf("BerlekampMassey returned (%v, %d), but expected (%v, 3)",
+			conn, l, expected)
+	}
+
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+		for rep := 0; rep < 20; rep++ {
+			// Generate a sequence from a random recurrence of length at most l
+			l := prg.Intn(8) + 1
+			c := make([]ff.Element, l+1)
+			for i := range c {
+				c[i] = field.RandElement()
+			}
+			seq := make([]ff.Element, 2*l+5)
+			for j := range seq {
+				if j < l {
+					seq[j] = field.RandElement()
+					continue
+				}
+				seq[j] = field.Zero()
+				for i := 1; i <= l; i++ {
+					seq[j].Sub(c[i].Times(seq[j-i]))
+				}
+			}
+
+			conn, complexity := ring.BerlekampMassey(seq)
+			if complexity > l {
+				t.Errorf("BerlekampMassey returned linear complexity %d, "+
+					"but sequence is generated by recurrence of length %d",
+					complexity, l)
+			}
+			if !conn.Coef(0).IsOne() || conn.Ld() > complexity {
+				t.Errorf("BerlekampMassey returned invalid connection "+
+					"polynomial %v for linear complexity %d", conn, complexity)
+			}
+			for j := complexity; j < len(seq); j++ {
+				sum := field.Zero()
+				for i := 0; i <= complexity; i++ {
+					sum.Add(conn.Coef(i).Times(seq[j-i]))
+				}
+				if sum.IsNonzero() {
+					t.Errorf("Connection polynomial %v does not generate "+
+						"sequence at index %d", conn, j)
+					break
+				}
+			}
+		}
+	}
+	fieldLoop(do)
+}
+
+func TestMinimalPolynomial(t *testing.T) {
+	for _, card := range [...]uint{4, 8, 9, 16, 25, 27, 49, 64, 125} {
+		field := defineField(card)
+		ring := univariate.DefRing(field)
+		prime := defineField(field.Char())
+		primeRing := univariate.DefRing(prime)
+
+		for _, a := range field.Elements() {
+			f := ring.MinimalPolynomial(a)
+			if f.Err() != nil {
+				t.Errorf("MinimalPolynomial returned error for %v: %q",
+					a, f.Err())
+				continue
+			}
+			if f.Eval(a).IsNonzero() {
+				t.Errorf("%v is not a root of its minimal polynomial %v", a, f)
+			}
+			if !f.Lc().IsOne() {
+				t.Errorf("Minimal polynomial %v is not monic", f)
+			}
+
+			g := primeRing.MinimalPolynomial(a)
+			if g.Err() != nil {
+				t.Errorf("MinimalPolynomial returned error for %v over prime "+
+					"field: %q", a, g.Err())
+				continue
+			}
+			if g.String() != f.String() {
+				t.Errorf("Minimal polynomials %v and %v over different fields "+
+					"differ", f, g)
+			}
+			if !g.IsIrreducible() {
+				t.Errorf("Minimal polynomial %v is not irreducible", g)
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package univariate
+
+import (
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// subproductTree stores the products of the linear factors X-u_i for a set of
+// points u_i. The leaves are stored at level zero, and each node at level k+1
+// is the product of two neighbouring nodes at level k. If a level contains an
+// odd number of nodes, the last node is carried to the next level unchanged.
+//
+// The inverse of the reversal of each node is computed when first needed by
+// the fast division algorithm.
+type subproductTree struct {
+	field  ff.Field
+	levels [][][]ff.Element
+	invs   [][][]ff.Element
+}
+
+// newSubproductTree computes the subproduct tree for the given points (see
+// [GG13; Algorithm 10.3]).
+func newSubproductTree(field ff.Field, points []ff.Element) *subproductTree {
+	leaves := make([][]ff.Element, len(points))
+	for i, p := range points {
+		leaves[i] = []ff.Element{p.Neg(), field.One()}
+	}
+
+	t := &subproductTree{
+		field:  field,
+		levels: [][][]ff.Element{leaves},
+	}
+	for cur := leaves; len(cur) > 1; {
+		next := make([][]ff.Element, (len(cur)+1)/2)
+		for j := range next {
+			if 2*j+1 < len(cur) {
+				next[j] = mulCoefs(field, cur[2*j], cur[2*j+1])
+			} else {
+				next[j] = cur[2*j]
+			}
+		}
+		t.levels = append(t.levels, next)
+		cur = next
+	}
+
+	t.invs = make([][][]ff.Element, len(t.levels))
+	for k := range t.levels {
+		t.invs[k] = make([][]ff.Element, len(t.levels[k]))
+	}
+	return t
+}
+
+// addPoint adds the linear factor X-p as the last leaf of t. Only the nodes on
+// the path from this leaf to the root are updated, and the remaining nodes are
+// left untouched.
+func (t *subproductTree) addPoint(p ff.Element) {
+	t.levels[0] = append(t.levels[0], []ff.Element{p.Neg(), t.field.One()})
+	t.invs[0] = append(t.invs[0], nil)
+
+	for k := 0; len(t.levels[k]) > 1; k++ {
+		// The last node at level k has changed, so its parent must be updated
+		cur := t.levels[k]
+		n := len(cur)
+		var parent []ff.Element
+		if n%2 == 0 {
+			parent = mulCoefs(t.field, cur[n-2], cur[n-1])
+		} else {
+			parent = cur[n-1]
+		}
+
+		switch j := (n - 1) / 2; {
+		case k+1 == len(t.levels):
+			t.levels = append(t.levels, [][]ff.Element{parent})
+			t.invs = append(t.invs, [][]ff.Element{nil})
+		case j < len(t.levels[k+1]):
+			t.levels[k+1][j] = parent
+			t.invs[k+1][j] = nil
+		default:
+			t.levels[k+1] = append(t.levels[k+1], parent)
+			t.invs[k+1] = append(t.invs[k+1], nil)
+		}
+	}
+}
+
+// root returns the product of all linear factors.
+func (t *subproductTree) root() []ff.Element {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// nPoints returns the number of points used to define t.
+func (t *subproductTree) nPoints() int {
+	return len(t.levels[0])
+}
+
+// rem computes a modulo the node j at level k.
+func (t *subproductTree) rem(a []ff.Element, k, j int) []ff.Element {
+	m := t.levels[k][j]
+	if t.invs[k][j] == nil && len(m)-1 >= karatsubaThreshold {
+		t.invs[k][j] = invSeries(t.field, reverseCoefs(t.field, m, len(m)), len(m)-1)
+	}
+	return remCoefs(t.field, a, m, t.invs[k][j])
+}
+
+// evaluate evaluates the polynomial with coefficients a in each of the points
+// of t (see [GG13; Algorithm 10.5]).
+func (t *subproductTree) evaluate(a []ff.Element) []ff.Element {
+	top := len(t.levels) - 1
+	rems := [][]ff.Element{t.rem(a, top, 0)}
+
+	for k := top - 1; k >= 0; k-- {
+		next := make([][]ff.Element, len(t.levels[k]))
+		for j := range next {
+			parent := rems[j/2]
+			if j%2 == 0 && j+1 == len(next) {
+				// Node was carried from this level. No reduction needed
+				next[j] = parent
+				continue
+			}
+			next[j] = t.rem(parent, k, j)
+		}
+		rems = next
+	}
+
+	out := make([]ff.Element, len(rems))
+	for i, r := range rems {
+		if len(r) == 0 {
+			out[i] = t.field.Zero()
+		} else {
+			out[i] = r[0].Copy()
+		}
+	}
+	return out
+}
+
+// linearCombination computes the sum of c_i*m/(X-u_i), where m is the product
+// of all linear factors of t (see [GG13; Algorithm 10.9]).
+func (t *subproductTree) linearCombination(c []ff.Element) []ff.Element {
+	cur := make([][]ff.Element, len(c))
+	for i := range c {
+		cur[i] = []ff.Element{c[i].Copy()}
+	}
+
+	for k := 0; k < len(t.levels)-1; k++ {
+		next := make([][]ff.Element, len(t.levels[k+1]))
+		for j := range next {
+			if 2*j+1 >= len(cur) {
+				next[j] = cur[2*j]
+				continue
+			}
+			left := mulCoefs(t.field, cur[2*j], t.levels[k][2*j+1])
+			right := mulCoefs(t.field, cur[2*j+1], t.levels[k][2*j])
+			next[j] = sumCoefs(t.field, left, right)
+		}
+		cur = next
+	}
+	return cur[0]
+}
+
+// EvalMulti evaluates f at each of the given points. The i'th entry of the
+// output is the evaluation at points[i].
+//
+// For a large number of points, the evaluation is done using a subproduct tree,
+// which is asymptotically faster than calling Eval for each point.
+func (f *Polynomial) EvalMulti(points []ff.Element) []ff.Element {
+	if len(points) == 0 {
+		return []ff.Element{}
+	}
+
+	if len(points) < karatsubaThreshold {
+		out := make([]ff.Element, len(points))
+		for i, p := range points {
+			out[i] = f.Eval(p)
+		}
+		return out
+	}
+
+	t := newSubproductTree(f.BaseField(), points)
+	return t.evaluate(f.Coefs())
+}
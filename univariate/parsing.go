@@ -7,6 +7,7 @@ import (
 
 	"github.com/ReneBoedker/algobra/errors"
 	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/internal/parsing"
 )
 
 type monomialMatch struct {
@@ -47,7 +48,7 @@ func (m *monomialMatch) degreeAndCoef(op errors.Op) (deg int, coef ff.Element, e
 	if m.coef == "" {
 		coef = m.field.One()
 	} else {
-		coef, err = m.field.ElementFromString(strings.Trim(m.coef, "()"))
+		coef, err = m.field.ElementFromString(parsing.TrimParens(m.coef))
 		if err != nil {
 			return deg, coef, errors.Wrap(op, errors.Conversion, err)
 		}
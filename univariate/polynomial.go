@@ -8,10 +8,15 @@ import (
 	"github.com/ReneBoedker/algobra/finitefield/ff"
 )
 
-// Polynomial denotes a bivariate polynomial.
+// Polynomial denotes a univariate polynomial.
+//
+// The coefficients are stored either densely in coefs or, for polynomials of
+// high degree with few terms, sparsely in sparse. Exactly one of the two is
+// in use at any time.
 type Polynomial struct {
 	baseRing *QuotientRing
 	coefs    []ff.Element
+	sparse   *sparseCoefs
 	err      error
 }
 
@@ -27,6 +32,9 @@ func (f *Polynomial) Err() error {
 
 // coefPtr returns a pointer to the coefficient of the monomial of degree deg.
 func (f *Polynomial) coefPtr(deg int) ff.Element {
+	if f.sparse != nil {
+		return f.sparse.get(deg)
+	}
 	if deg < len(f.coefs) {
 		return f.coefs[deg]
 	}
@@ -36,15 +44,15 @@ func (f *Polynomial) coefPtr(deg int) ff.Element {
 // Coef returns the coefficient of the monomial with degree specified by the
 // input. The return value is a finite field element.
 func (f *Polynomial) Coef(deg int) ff.Element {
-	if deg < len(f.coefs) && f.coefs[deg] != nil {
-		return f.coefs[deg].Copy()
+	if c := f.coefPtr(deg); c != nil {
+		return c.Copy()
 	}
 	return f.BaseField().Zero()
 }
 
 func (f *Polynomial) coefIsZero(deg int) bool {
-	if deg < len(f.coefs) && f.coefs[deg] != nil {
-		return f.coefs[deg].IsZero()
+	if c := f.coefPtr(deg); c != nil {
+		return c.IsZero()
 	}
 	return true
 }
@@ -52,6 +60,15 @@ func (f *Polynomial) coefIsZero(deg int) bool {
 // SetCoefPtr sets the coefficient of the monomial with degree deg in f to val
 // as a pointer. It returns f itself.
 func (f *Polynomial) SetCoefPtr(deg int, val ff.Element) *Polynomial {
+	if f.sparse == nil && deg > f.Ld() && val.IsNonzero() && f.shouldGrowSparse(deg) {
+		f.toSparse()
+	}
+	if f.sparse != nil {
+		f.sparse.set(deg, val)
+		f.adjustSparse()
+		return f
+	}
+
 	if deg <= f.Ld() {
 		f.coefs[deg] = val
 		if val.IsZero() {
@@ -75,6 +92,11 @@ func (f *Polynomial) SetCoefPtr(deg int, val ff.Element) *Polynomial {
 // removeCoef sets the given coefficient to zero, and reslices the internal
 // representation if needed.
 func (f *Polynomial) removeCoef(deg int) {
+	if f.sparse != nil {
+		f.sparse.set(deg, f.BaseField().Zero())
+		f.adjustSparse()
+		return
+	}
 	if deg <= f.Ld() {
 		f.coefs[deg].SetUnsigned(0)
 		f.reslice()
@@ -93,6 +115,14 @@ func (f *Polynomial) IncrementCoef(deg int, val ff.Element) {
 	if val.IsZero() {
 		return
 	}
+	if f.sparse != nil || (deg > f.Ld() && f.shouldGrowSparse(deg)) {
+		if c := f.coefPtr(deg); c != nil {
+			f.SetCoefPtr(deg, c.Add(val))
+		} else {
+			f.SetCoefPtr(deg, val.Copy())
+		}
+		return
+	}
 	if deg <= f.Ld() {
 		if f.coefs[deg] != nil {
 			f.coefs[deg].Add(val)
@@ -116,6 +146,14 @@ func (f *Polynomial) DecrementCoef(deg int, val ff.Element) {
 	if val.IsZero() {
 		return
 	}
+	if f.sparse != nil || (deg > f.Ld() && f.shouldGrowSparse(deg)) {
+		if c := f.coefPtr(deg); c != nil {
+			f.SetCoefPtr(deg, c.Sub(val))
+		} else {
+			f.SetCoefPtr(deg, val.Neg())
+		}
+		return
+	}
 	if deg <= f.Ld() {
 		if f.coefs[deg] != nil {
 			f.coefs[deg].Sub(val)
@@ -149,6 +187,18 @@ func (f *Polynomial) reslice() {
 // coefficients as f.
 func (f *Polynomial) Copy() *Polynomial {
 	h := f.baseRing.Zero()
+	if f.sparse != nil {
+		h.coefs = nil
+		h.sparse = &sparseCoefs{
+			degs:  make([]int, len(f.sparse.degs)),
+			coefs: make([]ff.Element, len(f.sparse.coefs)),
+		}
+		copy(h.sparse.degs, f.sparse.degs)
+		for i, c := range f.sparse.coefs {
+			h.sparse.coefs[i] = c.Copy()
+		}
+		return h
+	}
 	h.coefs = make([]ff.Element, len(f.coefs))
 	for deg, c := range f.coefs {
 		if c == nil {
@@ -184,6 +234,13 @@ func (f *Polynomial) EmbedIn(r *QuotientRing, reduce bool) error {
 // Eval evaluates f at the given point.
 func (f *Polynomial) Eval(point ff.Element) ff.Element {
 	out := f.BaseField().Zero()
+	if f.sparse != nil {
+		for i, deg := range f.sparse.degs {
+			out.Add(point.Pow(uint(deg)).Mult(f.sparse.coefs[i]))
+		}
+		return out
+	}
+
 	power := f.BaseField().One()
 	tmp := f.BaseField().Zero()
 	for deg, c := range f.coefs {
@@ -219,12 +276,9 @@ func (f *Polynomial) SetScale(c ff.Element) *Polynomial {
 		f.SetZero()
 		return f
 	}
-	for deg, coef := range f.coefs {
-		if coef == nil {
-			continue
-		}
-		f.coefs[deg].Mult(c)
-	}
+	f.forEachTerm(func(_ int, coef ff.Element) {
+		coef.Mult(c)
+	})
 	return f
 }
 
@@ -238,6 +292,13 @@ func (f *Polynomial) Scale(c ff.Element) *Polynomial {
 //
 // The list is sorted with higher degrees preceding lower ones in the list.
 func (f *Polynomial) Degrees() []int {
+	if f.sparse != nil {
+		degs := make([]int, len(f.sparse.degs))
+		for i, deg := range f.sparse.degs {
+			degs[len(degs)-1-i] = deg
+		}
+		return degs
+	}
 	degs := make([]int, 0, len(f.coefs))
 	for deg := len(f.coefs) - 1; deg >= 0; deg-- {
 		if f.coefIsZero(deg) {
@@ -253,6 +314,9 @@ func (f *Polynomial) NTerms() (c uint) {
 	if f.IsZero() {
 		return 1
 	}
+	if f.sparse != nil {
+		return uint(len(f.sparse.degs))
+	}
 	for deg := len(f.coefs) - 1; deg >= 0; deg-- {
 		if !f.coefIsZero(deg) {
 			c++
@@ -265,6 +329,13 @@ func (f *Polynomial) NTerms() (c uint) {
 //
 // The i'th element of the resulting slice is the coefficient of degree i.
 func (f *Polynomial) Coefs() []ff.Element {
+	if f.sparse != nil {
+		coefs := make([]ff.Element, f.Ld()+1)
+		for deg := range coefs {
+			coefs[deg] = f.Coef(deg)
+		}
+		return coefs
+	}
 	coefs := make([]ff.Element, len(f.coefs), len(f.coefs))
 	for i, c := range f.coefs {
 		if c == nil {
@@ -278,6 +349,9 @@ func (f *Polynomial) Coefs() []ff.Element {
 
 // Ld returns the leading degree of f.
 func (f *Polynomial) Ld() int {
+	if f.sparse != nil {
+		return f.sparse.ld()
+	}
 	return len(f.coefs) - 1
 }
 
@@ -288,6 +362,9 @@ func (f *Polynomial) Lc() ff.Element {
 
 // lcPtr returns a pointer to the leading coefficient of f.
 func (f *Polynomial) lcPtr() ff.Element {
+	if f.sparse != nil {
+		return f.sparse.coefs[len(f.sparse.coefs)-1]
+	}
 	return f.coefs[f.Ld()]
 }
 
@@ -301,7 +378,8 @@ func (f *Polynomial) Lt() *Polynomial {
 
 // IsZero determines whether f is the zero polynomial.
 func (f *Polynomial) IsZero() bool {
-	if len(f.coefs) == 1 && f.coefs[0].IsZero() {
+	// Sparse polynomials are never zero since their degree is large
+	if f.sparse == nil && len(f.coefs) == 1 && f.coefs[0].IsZero() {
 		return true
 	}
 	return false
@@ -314,7 +392,7 @@ func (f *Polynomial) IsNonzero() bool {
 
 // IsOne determines whether f is the constant 1.
 func (f *Polynomial) IsOne() bool {
-	if len(f.coefs) == 1 && f.coefPtr(0).IsOne() {
+	if f.sparse == nil && len(f.coefs) == 1 && f.coefPtr(0).IsOne() {
 		return true
 	}
 	return false
@@ -413,11 +491,7 @@ func (f *Polynomial) String() string {
 	}
 
 	var b strings.Builder
-	for d := f.Ld(); d >= 0; d-- {
-		if f.coefIsZero(d) {
-			continue
-		}
-
+	for _, d := range f.Degrees() {
 		if d < f.Ld() {
 			b.Write([]byte(" + "))
 		}
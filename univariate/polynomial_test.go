@@ -22,6 +22,25 @@ func defineField(card uint) ff.Field {
 	return field
 }
 
+// randomPolynomial returns a polynomial with random coefficients of degree at
+// most deg.
+func randomPolynomial(ring *univariate.QuotientRing, deg int) *univariate.Polynomial {
+	coefs := make([]ff.Element, deg+1)
+	for i := range coefs {
+		coefs[i] = ring.BaseField().RandElement()
+	}
+	return ring.Polynomial(coefs)
+}
+
+// polynomialFromRoots returns c times the product of X-a for each a in roots.
+func polynomialFromRoots(ring *univariate.QuotientRing, c ff.Element, roots []ff.Element) *univariate.Polynomial {
+	f := ring.Polynomial([]ff.Element{c})
+	for _, a := range roots {
+		f.Mult(ring.Polynomial([]ff.Element{a.Neg(), ring.BaseField().One()}))
+	}
+	return f
+}
+
 func fieldLoop(do func(field ff.Field), minCard ...uint) {
 	for _, card := range [...]uint{2, 3, 4, 5, 9, 16, 25, 49, 64, 125} {
 		if len(minCard) > 0 && card < minCard[0] {
@@ -441,3 +460,194 @@ func TestPow(t *testing.T) {
 		}
 	}
 }
+
+func TestExtendedGcd(t *testing.T) {
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+
+		for rep := 0; rep < 50; rep++ {
+			fCoefs := make([]ff.Element, prg.Intn(8)+1)
+			for i := range fCoefs {
+				fCoefs[i] = field.RandElement()
+			}
+			gCoefs := make([]ff.Element, prg.Intn(8)+1)
+			for i := range gCoefs {
+				gCoefs[i] = field.RandElement()
+			}
+			f := ring.Polynomial(fCoefs)
+			g := ring.Polynomial(gCoefs)
+
+			d, s, u, err := univariate.ExtendedGcd(f, g)
+			if err != nil {
+				t.Errorf("ExtendedGcd returned an error: %q", err)
+				continue
+			}
+
+			gcd, _ := univariate.Gcd(f, g)
+			if !d.Equal(gcd.Normalize()) {
+				t.Errorf(
+					"ExtendedGcd(%v, %v) returned gcd %v, but expected %v",
+					f, g, d, gcd.Normalize(),
+				)
+			}
+
+			if comb := s.Times(f).Plus(u.Times(g)); !comb.Equal(d) {
+				t.Errorf(
+					"(%v)*(%v) + (%v)*(%v) = %v, but expected %v",
+					s, f, u, g, comb, d,
+				)
+			}
+		}
+	}
+
+	fieldLoop(do)
+
+	field1 := defineField(3)
+	field2 := defineField(5)
+	_, _, _, err := univariate.ExtendedGcd(
+		univariate.DefRing(field1).One(),
+		univariate.DefRing(field2).One(),
+	)
+	if err == nil {
+		t.Errorf("ExtendedGcd returned no error even though polynomials are " +
+			"defined over different rings")
+	} else if !errors.Is(errors.InputIncompatible, err) {
+		t.Errorf(
+			"ExtendedGcd returned an error but of unexpected kind (err = %v)",
+			err,
+		)
+	}
+}
+
+func TestPartialExtendedGcd(t *testing.T) {
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+
+		for rep := 0; rep < 50; rep++ {
+			fCoefs := make([]ff.Element, prg.Intn(8)+1)
+			for i := range fCoefs {
+				fCoefs[i] = field.RandElement()
+			}
+			gCoefs := make([]ff.Element, prg.Intn(8)+1)
+			for i := range gCoefs {
+				gCoefs[i] = field.RandElement()
+			}
+			f := ring.Polynomial(fCoefs)
+			g := ring.Polynomial(gCoefs)
+			degBound := prg.Intn(8)
+
+			r, s, u, err := univariate.PartialExtendedGcd(f, g, degBound)
+			if err != nil {
+				t.Errorf("PartialExtendedGcd returned an error: %q", err)
+				continue
+			}
+
+			if r.IsNonzero() && r.Ld() >= degBound {
+				t.Errorf(
+					"PartialExtendedGcd(%v, %v, %d) returned remainder %v "+
+						"of too high degree",
+					f, g, degBound, r,
+				)
+			}
+
+			if comb := s.Times(f).Plus(u.Times(g)); !comb.Equal(r) {
+				t.Errorf(
+					"(%v)*(%v) + (%v)*(%v) = %v, but expected %v",
+					s, f, u, g, comb, r,
+				)
+			}
+
+			// Without a bound, the algorithm runs until the remainder is zero
+			if r, _, _, _ := univariate.PartialExtendedGcd(f, g, 0); r.IsNonzero() {
+				t.Errorf(
+					"PartialExtendedGcd(%v, %v, 0) returned non-zero remainder %v",
+					f, g, r,
+				)
+			}
+		}
+	}
+
+	fieldLoop(do)
+
+	field1 := defineField(3)
+	field2 := defineField(5)
+	_, _, _, err := univariate.PartialExtendedGcd(
+		univariate.DefRing(field1).One(),
+		univariate.DefRing(field2).One(),
+		1,
+	)
+	if err == nil {
+		t.Errorf("PartialExtendedGcd returned no error even though " +
+			"polynomials are defined over different rings")
+	} else if !errors.Is(errors.InputIncompatible, err) {
+		t.Errorf(
+			"PartialExtendedGcd returned an error but of unexpected kind "+
+				"(err = %v)",
+			err,
+		)
+	}
+}
+
+func TestInv(t *testing.T) {
+	field := defineField(7)
+	ring := univariate.DefRing(field)
+
+	// X^3 + 3 is irreducible over GF(7)
+	id, err := ring.NewIdeal(ring.PolynomialFromUnsigned([]uint{3, 0, 0, 1}))
+	if err != nil {
+		panic(err)
+	}
+	qr, err := ring.Quotient(id)
+	if err != nil {
+		panic(err)
+	}
+
+	for rep := 0; rep < 100; rep++ {
+		f := qr.Polynomial([]ff.Element{
+			field.RandElement(),
+			field.RandElement(),
+			field.RandElement(),
+		})
+
+		inv := f.Inv()
+		if f.IsZero() {
+			if inv.Err() == nil {
+				t.Errorf("Inverting zero did not return an error")
+			} else if !errors.Is(errors.InputValue, inv.Err()) {
+				t.Errorf(
+					"Inverting zero returned an error of unexpected kind "+
+						"(err = %v)", inv.Err(),
+				)
+			}
+			continue
+		}
+
+		if inv.Err() != nil {
+			t.Errorf("Inverting %v returned an error: %q", f, inv.Err())
+		} else if prod := f.Times(inv); !prod.IsOne() {
+			t.Errorf("(%v) * (%v) = %v, but expected 1", f, inv, prod)
+		}
+	}
+
+	// X^2 - 1 = (X+1)(X-1), so X+1 is not a unit modulo this polynomial
+	id, _ = ring.NewIdeal(ring.PolynomialFromSigned([]int{-1, 0, 1}))
+	qr, _ = ring.Quotient(id)
+	if inv := qr.PolynomialFromUnsigned([]uint{1, 1}).Inv(); inv.Err() == nil {
+		t.Errorf("Inverting a zero divisor did not return an error")
+	} else if !errors.Is(errors.InputValue, inv.Err()) {
+		t.Errorf(
+			"Inverting a zero divisor returned an error of unexpected kind "+
+				"(err = %v)", inv.Err(),
+		)
+	}
+
+	// Without an ideal, only the non-zero constants are units
+	if inv := ring.PolynomialFromUnsigned([]uint{3}).Inv(); inv.Err() != nil {
+		t.Errorf("Inverting 3 returned an error: %q", inv.Err())
+	} else if !inv.Equal(ring.PolynomialFromUnsigned([]uint{5})) {
+		t.Errorf("Inverting 3 gave %v rather than 5", inv)
+	}
+	if inv := ring.PolynomialFromUnsigned([]uint{0, 1}).Inv(); inv.Err() == nil {
+		t.Errorf("Inverting X in a polynomial ring did not return an error")
+	}
+}
@@ -0,0 +1,103 @@
+package univariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+)
+
+// RationalReconstruction finds polynomials a and b such that a = b*f modulo m,
+// where deg(a) <= degA, deg(b) <= degB, and b is monic and coprime to m. If
+// such polynomials exist, they are unique up to multiplication by a common
+// factor, and a and b are returned in lowest terms.
+//
+// The degree bounds must be non-negative and satisfy degA+degB < deg(m).
+// Otherwise, an InputValue-error is returned. An InputValue-error is also
+// returned if no reconstruction exists. If f and m are not defined over the same
+// ring, an InputIncompatible-error is returned.
+func RationalReconstruction(f, m *Polynomial, degA, degB int) (a, b *Polynomial, err error) {
+	// The implementation is based on [GG13; Section 5.7]
+	const op = "Computing rational reconstruction"
+
+	if f.baseRing != m.baseRing {
+		return nil, nil, errors.New(
+			op, errors.InputIncompatible,
+			"Polynomials defined over different rings",
+		)
+	}
+	if tmp := hasErr(op, f, m); tmp != nil {
+		return nil, nil, tmp.Err()
+	}
+	if degA < 0 || degB < 0 {
+		return nil, nil, errors.New(
+			op, errors.InputValue,
+			"Degree bounds must be non-negative (received %d and %d)",
+			degA, degB,
+		)
+	}
+	if m.IsZero() || degA+degB >= m.Ld() {
+		return nil, nil, errors.New(
+			op, errors.InputValue,
+			"Sum of degree bounds %d and %d must be less than the degree of "+
+				"the modulus %v", degA, degB, m,
+		)
+	}
+
+	// Run the extended Euclidean algorithm on m and f until the first remainder
+	// of degree at most degA. Only the cofactor of f is needed.
+	_, fRem, err := f.QuoRem(m)
+	if err != nil {
+		return nil, nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	r, _, t, err := PartialExtendedGcd(m, fRem, degA+1)
+	if err != nil {
+		return nil, nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	noSolution := func() (*Polynomial, *Polynomial, error) {
+		return nil, nil, errors.New(
+			op, errors.InputValue,
+			"%v has no rational reconstruction modulo %v with degree bounds "+
+				"%d and %d", f, m, degA, degB,
+		)
+	}
+
+	if t.Ld() > degB {
+		return noSolution()
+	}
+	if g, err := Gcd(r, t); err != nil {
+		return nil, nil, errors.Wrap(op, errors.Inherit, err)
+	} else if g.Ld() > 0 {
+		return noSolution()
+	}
+
+	lcInv := t.lcPtr().Inv()
+	return r.SetScale(lcInv), t.SetScale(lcInv), nil
+}
+
+// PadeApproximant finds polynomials a and b with deg(a) <= degA and
+// deg(b) <= degB such that a/b = f modulo X^n. The polynomials are normalized
+// such that b has constant term one.
+//
+// The degree bounds must satisfy degA+degB < n. The function returns an
+// InputValue-error if this is not the case or if no approximant exists.
+func PadeApproximant(f *Polynomial, n, degA, degB int) (a, b *Polynomial, err error) {
+	const op = "Computing Padé approximant"
+
+	if n < 1 {
+		return nil, nil, errors.New(
+			op, errors.InputValue,
+			"Precision must be positive (received %d)", n,
+		)
+	}
+
+	m := f.baseRing.zeroWithCap(n + 1)
+	m.SetCoefPtr(n, f.BaseField().One())
+
+	a, b, err = RationalReconstruction(f, m, degA, degB)
+	if err != nil {
+		return nil, nil, errors.Wrap(op, errors.Inherit, err)
+	}
+
+	// Since b is coprime to X^n, its constant term is non-zero
+	c := b.Coef(0).Inv()
+	return a.SetScale(c), b.SetScale(c), nil
+}
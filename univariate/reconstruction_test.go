@@ -0,0 +1,70 @@
+package univariate_test
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func TestRationalReconstruction(t *testing.T) {
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+		for rep := 0; rep < 20; rep++ {
+			degA, degB := prg.Intn(5), prg.Intn(5)
+
+			degM := degA + degB + 1 + prg.Intn(3)
+			var m *univariate.Polynomial
+			for m == nil || m.Ld() != degM || !m.IsIrreducible() {
+				m = randomPolynomial(ring, degM)
+			}
+
+			a := randomPolynomial(ring, degA)
+			b := randomPolynomial(ring, degB)
+			if b.IsZero() {
+				continue
+			}
+			_, bInv, _, _ := univariate.ExtendedGcd(b, m)
+			_, f, _ := a.Times(bInv).QuoRem(m)
+
+			a2, b2, err := univariate.RationalReconstruction(f, m, degA, degB)
+			if err != nil {
+				t.Errorf("RationalReconstruction returned an error: %q", err)
+				continue
+			}
+			if a2.Ld() > degA || b2.Ld() > degB || !b2.Lc().IsOne() {
+				t.Errorf("RationalReconstruction returned (%v, %v), which "+
+					"violates degree bounds (%d, %d)", a2, b2, degA, degB)
+			}
+			if !a2.Times(b).Equal(a.Times(b2)) {
+				t.Errorf("RationalReconstruction returned %v/%v, but "+
+					"expected %v/%v", a2, b2, a, b)
+			}
+		}
+	}
+	fieldLoop(do)
+
+	field := defineField(7)
+	ring := univariate.DefRing(field)
+	m := ring.PolynomialFromUnsigned([]uint{0, 0, 0, 0, 1})
+	// 1 + X^2 is not of the form a/b with deg(a), deg(b) <= 1 modulo X^4
+	f := ring.PolynomialFromUnsigned([]uint{1, 0, 1})
+	_, _, err := univariate.RationalReconstruction(f, m, 1, 1)
+	if err == nil {
+		t.Errorf("RationalReconstruction returned no error for input without " +
+			"solution")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("RationalReconstruction returned an error of unexpected "+
+			"kind (err = %v)", err)
+	}
+
+	_, _, err = univariate.RationalReconstruction(f, m, 2, 2)
+	if err == nil {
+		t.Errorf("RationalReconstruction returned no error for too large " +
+			"degree bounds")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("RationalReconstruction returned an error of unexpected "+
+			"kind (err = %v)", err)
+	}
+}
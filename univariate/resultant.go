@@ -0,0 +1,99 @@
+package univariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// Resultant returns the resultant of f and g. That is, the determinant of the
+// Sylvester matrix of f and g.
+//
+// If f or g is zero, the resultant is zero. If f is a non-zero constant c, the
+// resultant is c^deg(g), and similarly if g is a non-zero constant.
+//
+// If f and g are defined over different rings, an ArithmeticIncompat-error is
+// returned. If f and g are defined over a quotient ring, the resultant is
+// computed from their reduced representatives.
+func (f *Polynomial) Resultant(g *Polynomial) (ff.Element, error) {
+	// The implementation uses the Euclidean algorithm as described in
+	// [GG13; Section 6.3]
+	const op = "Computing resultant"
+
+	if tmp := checkErrAndCompatible(op, f, g); tmp != nil {
+		return nil, tmp.Err()
+	}
+
+	res := f.BaseField().One()
+	a, b := f.Copy(), g.Copy()
+	for {
+		if a.IsZero() || b.IsZero() {
+			return f.BaseField().Zero(), nil
+		}
+
+		n, m := a.Ld(), b.Ld()
+		switch {
+		case m == 0:
+			return res.Mult(b.lcPtr().Pow(uint(n))), nil
+		case n == 0:
+			return res.Mult(a.lcPtr().Pow(uint(m))), nil
+		}
+
+		// Use that res(a, b) = (-1)^(nm)*lc(b)^(n-k)*res(b, r), where r is the
+		// remainder of a modulo b and k is its degree
+		_, r, err := a.QuoRem(b)
+		if err != nil {
+			return nil, errors.Wrap(op, errors.Inherit, err)
+		}
+		if r.IsZero() {
+			return f.BaseField().Zero(), nil
+		}
+		if n*m%2 == 1 {
+			res.SetNeg()
+		}
+		res.Mult(b.lcPtr().Pow(uint(n - r.Ld())))
+		a, b = b, r
+	}
+}
+
+// Discriminant returns the discriminant of f. If f has leading coefficient c
+// and roots a_1, ..., a_n in an algebraic closure, this is
+//
+//	c^(2n-2) times the product of (a_i-a_j)^2 for i < j.
+//
+// In particular, the discriminant is zero if and only if f has a repeated root.
+//
+// If f is constant, an InputValue-error is returned.
+func (f *Polynomial) Discriminant() (ff.Element, error) {
+	const op = "Computing discriminant"
+
+	if tmp := hasErr(op, f); tmp != nil {
+		return nil, tmp.Err()
+	}
+	if f.IsZero() || f.Ld() < 1 {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Discriminant is only defined for polynomials of positive degree",
+		)
+	}
+
+	// Use that disc(f) = (-1)^(n(n-1)/2)*lc(f)^(n-2-deg(f'))*res(f, f')
+	n := f.Ld()
+	d := f.Derivative()
+	res, err := f.Resultant(d)
+	if err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, err)
+	}
+	if res.IsZero() {
+		return res, nil
+	}
+
+	if e := n - 2 - d.Ld(); e >= 0 {
+		res.Mult(f.lcPtr().Pow(uint(e)))
+	} else {
+		res.Mult(f.lcPtr().Inv().Pow(uint(-e)))
+	}
+	if (n*(n-1)/2)%2 == 1 {
+		res.SetNeg()
+	}
+	return res, nil
+}
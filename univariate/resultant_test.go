@@ -0,0 +1,118 @@
+package univariate_test
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func TestResultant(t *testing.T) {
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+		for rep := 0; rep < 20; rep++ {
+			roots := make([]ff.Element, prg.Intn(6))
+			for i := range roots {
+				roots[i] = field.RandElement()
+			}
+			c := field.RandElement()
+			if c.IsZero() {
+				c = field.One()
+			}
+			f := polynomialFromRoots(ring, c, roots)
+			g := randomPolynomial(ring, prg.Intn(6))
+
+			// Compute c^deg(g) times the product of g(a) for each root a
+			expected := field.Zero()
+			if g.IsNonzero() {
+				expected = c.Pow(uint(g.Ld()))
+				for _, a := range roots {
+					expected.Mult(g.Eval(a))
+				}
+			}
+
+			res, err := f.Resultant(g)
+			if err != nil {
+				t.Errorf("Resultant returned an error: %q", err)
+			} else if !res.Equal(expected) {
+				t.Errorf("Resultant of %v and %v was %v, but expected %v",
+					f, g, res, expected)
+			}
+
+			// Swapping the arguments changes the sign by (-1)^(deg(f)deg(g))
+			if f.Ld()*g.Ld()%2 == 1 {
+				expected.SetNeg()
+			}
+			if res, err := g.Resultant(f); err != nil {
+				t.Errorf("Resultant returned an error: %q", err)
+			} else if !res.Equal(expected) {
+				t.Errorf("Resultant of %v and %v was %v, but expected %v",
+					g, f, res, expected)
+			}
+		}
+	}
+	fieldLoop(do)
+
+	field := defineField(7)
+	ring := univariate.DefRing(field)
+	f := ring.PolynomialFromUnsigned([]uint{1, 2, 3})
+	if res, err := f.Resultant(ring.Zero()); err != nil || res.IsNonzero() {
+		t.Errorf("Resultant of %v and zero was (%v, %v)", f, res, err)
+	}
+	if res, err := f.Resultant(ring.PolynomialFromUnsigned([]uint{2})); err != nil ||
+		!res.Equal(field.ElementFromUnsigned(4)) {
+		t.Errorf("Resultant of %v and 2 was (%v, %v)", f, res, err)
+	}
+
+	other := univariate.DefRing(field)
+	if _, err := f.Resultant(other.One()); err == nil {
+		t.Errorf("Resultant succeeded for polynomials from different rings")
+	} else if !errors.Is(errors.ArithmeticIncompat, err) {
+		t.Errorf("Resultant returned an error of unexpected kind (err = %v)",
+			err)
+	}
+}
+
+func TestDiscriminant(t *testing.T) {
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+		for rep := 0; rep < 20; rep++ {
+			roots := make([]ff.Element, prg.Intn(6)+1)
+			for i := range roots {
+				roots[i] = field.RandElement()
+			}
+			c := field.RandElement()
+			if c.IsZero() {
+				c = field.One()
+			}
+			f := polynomialFromRoots(ring, c, roots)
+
+			expected := c.Pow(uint(2*len(roots) - 2))
+			for i := range roots {
+				for j := i + 1; j < len(roots); j++ {
+					expected.Mult(roots[i].Minus(roots[j]).Pow(2))
+				}
+			}
+
+			disc, err := f.Discriminant()
+			if err != nil {
+				t.Errorf("Discriminant returned an error: %q", err)
+			} else if !disc.Equal(expected) {
+				t.Errorf("Discriminant of %v was %v, but expected %v",
+					f, disc, expected)
+			}
+		}
+	}
+	fieldLoop(do)
+
+	ring := univariate.DefRing(defineField(5))
+	for _, f := range []*univariate.Polynomial{ring.Zero(), ring.One()} {
+		if _, err := f.Discriminant(); err == nil {
+			t.Errorf("Discriminant succeeded for constant %v", f)
+		} else if !errors.Is(errors.InputValue, err) {
+			t.Errorf("Discriminant returned an error of unexpected kind "+
+				"(err = %v)", err)
+		}
+	}
+}
@@ -74,6 +74,20 @@ func (r *QuotientRing) VarName() string {
 	return r.varName
 }
 
+// BaseField returns the field over which the polynomials of r are defined.
+func (r *QuotientRing) BaseField() ff.Field {
+	return r.baseField
+}
+
+// Ideal returns a copy of the ideal defining the quotient ring r. If r is not
+// a proper quotient, the return value is nil.
+func (r *QuotientRing) Ideal() *Ideal {
+	if r.id == nil {
+		return nil
+	}
+	return r.id.Copy()
+}
+
 // zeroWithCap returns a zero polynomial over the specified ring, where the
 // underlying representation has given capacity. If cap is less than one, the
 // default map capacity is used.
@@ -0,0 +1,99 @@
+package univariate
+
+import (
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// Roots returns the distinct roots of f in its base field. The roots are
+// returned in no particular order.
+//
+// The roots are found by first computing the greatest common divisor g of f and
+// X^q-X, where q is the cardinality of the field. This is the product of the
+// linear factors of f. Then g is split using the equal-degree factorization of
+// Cantor and Zassenhaus (see [GG13; Section 14.3]). When the field is small
+// compared to the degree of f, the roots are instead found by evaluating f in
+// all elements of the field.
+//
+// If f is defined over a quotient ring, the roots of f itself rather than of
+// its residue class are returned.
+//
+// If f is the zero polynomial, an InputValue-error is returned.
+func (f *Polynomial) Roots() ([]ff.Element, error) {
+	const op = "Computing roots"
+
+	if f.err != nil {
+		return nil, errors.Wrap(op, errors.Inherit, f.err)
+	}
+	if f.IsZero() {
+		return nil, errors.New(
+			op, errors.InputValue,
+			"Every element is a root of the zero polynomial",
+		)
+	}
+
+	field := f.BaseField()
+	out := make([]ff.Element, 0)
+	if f.Ld() < 1 {
+		return out, nil
+	}
+
+	if d := uint(f.Ld()); field.Card() <= d*d {
+		for _, e := range field.Elements() {
+			if f.Eval(e).IsZero() {
+				out = append(out, e)
+			}
+		}
+		return out, nil
+	}
+
+	x := f.baseRing.zeroWithCap(2)
+	x.SetCoefPtr(1, field.One())
+
+	// Ignore error since all polynomials are defined over the same ring
+	g, _ := Gcd(f, x.powMod(field.Card(), f).Sub(x))
+	return g.Normalize().splitLinear(out), nil
+}
+
+// splitLinear appends the roots of f to out. The polynomial f must be monic
+// and a product of distinct linear factors.
+func (f *Polynomial) splitLinear(out []ff.Element) []ff.Element {
+	switch {
+	case f.Ld() < 1:
+		return out
+	case f.Ld() == 1:
+		return append(out, f.Coef(0).Neg())
+	}
+
+	field := f.BaseField()
+	for {
+		// Choose a random polynomial h of degree at most one. Then the
+		// splitting polynomial vanishes in approximately half of the roots of f
+		h := f.baseRing.zeroWithCap(2)
+		h.SetCoefPtr(1, field.RandElement())
+		h.SetCoefPtr(0, field.RandElement())
+
+		var split *Polynomial
+		if field.Char() == 2 {
+			// Use the trace h + h^2 + ... + h^(q/2) instead of a power of h
+			split = h.Copy()
+			for k := uint(2); k < field.Card(); k *= 2 {
+				_, h, _ = h.multNoReduce(h).QuoRem(f)
+				split.Add(h)
+			}
+		} else {
+			split = h.powMod((field.Card()-1)/2, f)
+			split.DecrementCoef(0, field.One())
+		}
+
+		// Ignore error since all polynomials are defined over the same ring
+		g, _ := Gcd(f, split)
+		if g.Ld() < 1 || g.Ld() == f.Ld() {
+			continue
+		}
+		g = g.Normalize()
+		q, _, _ := f.QuoRem(g)
+		out = g.splitLinear(out)
+		return q[0].splitLinear(out)
+	}
+}
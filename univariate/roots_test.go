@@ -0,0 +1,71 @@
+package univariate_test
+
+import (
+	"testing"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func TestRoots(t *testing.T) {
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+		for rep := 0; rep < 20; rep++ {
+			// Multiply a product of linear factors, possibly with repetitions,
+			// by a random polynomial
+			roots := make([]ff.Element, prg.Intn(6))
+			for i := range roots {
+				roots[i] = field.RandElement()
+			}
+			f := polynomialFromRoots(ring, field.One(), roots)
+			coefs := make([]ff.Element, prg.Intn(4)+1)
+			for i := range coefs {
+				coefs[i] = field.RandElement()
+			}
+			coefs[len(coefs)-1] = field.One()
+			f.Mult(ring.Polynomial(coefs))
+
+			found, err := f.Roots()
+			if err != nil {
+				t.Errorf("Roots of %v returned an error: %q", f, err)
+				continue
+			}
+			expected := make(map[string]bool)
+			for _, e := range field.Elements() {
+				if f.Eval(e).IsZero() {
+					expected[e.String()] = true
+				}
+			}
+			for _, a := range found {
+				if !expected[a.String()] {
+					t.Errorf("Roots of %v contained %v or repeated it", f, a)
+				}
+				delete(expected, a.String())
+			}
+			if len(expected) > 0 {
+				t.Errorf("Roots of %v returned %v, but missed %v", f, found, expected)
+			}
+		}
+	}
+
+	fieldLoop(do)
+
+	// Use a large field to avoid evaluating in all elements
+	field := defineField(1 << 16)
+	ring := univariate.DefRing(field)
+	roots := []ff.Element{field.Zero(), field.One(), field.MultGenerator()}
+	f := polynomialFromRoots(ring, field.One(), roots)
+	f.Mult(ring.PolynomialFromUnsigned([]uint{1, 1, 0, 1}))
+	if found, err := f.Roots(); err != nil {
+		t.Errorf("Roots of %v returned an error: %q", f, err)
+	} else if len(found) != len(roots) {
+		t.Errorf("Roots of %v returned %v, but expected %v", f, found, roots)
+	}
+
+	if _, err := ring.Zero().Roots(); err == nil {
+		t.Errorf("Roots of the zero polynomial returned no error")
+	} else if !errors.Is(errors.InputValue, err) {
+		t.Errorf("Roots returned an error of unexpected kind (err = %v)", err)
+	}
+}
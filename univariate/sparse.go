@@ -0,0 +1,196 @@
+package univariate
+
+import (
+	"sort"
+
+	"github.com/ReneBoedker/algobra/errors"
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+)
+
+// Polynomials of high degree with few non-zero terms are stored sparsely. This
+// happens when the degree is at least sparseThreshold and at most one in
+// sparseRatio of the coefficients are non-zero. A sparse polynomial is
+// converted back to the dense representation when its degree drops below
+// sparseThreshold or when more than two in sparseRatio of its coefficients are
+// non-zero.
+const (
+	sparseThreshold = 64
+	sparseRatio     = 8
+)
+
+// sparseCoefs is a sparse representation of the coefficients of a polynomial.
+// The degrees are sorted in increasing order, and coefs[i] is the coefficient
+// of degree degs[i]. All stored coefficients are non-zero.
+type sparseCoefs struct {
+	degs  []int
+	coefs []ff.Element
+}
+
+// index returns the position of deg in s, and a boolean describing whether deg
+// is present. If it is not present, the position is where it should be
+// inserted.
+func (s *sparseCoefs) index(deg int) (int, bool) {
+	i := sort.SearchInts(s.degs, deg)
+	return i, i < len(s.degs) && s.degs[i] == deg
+}
+
+// get returns a pointer to the coefficient of degree deg, or nil if it is zero.
+func (s *sparseCoefs) get(deg int) ff.Element {
+	if i, ok := s.index(deg); ok {
+		return s.coefs[i]
+	}
+	return nil
+}
+
+// set sets the coefficient of degree deg to val as a pointer.
+func (s *sparseCoefs) set(deg int, val ff.Element) {
+	i, ok := s.index(deg)
+	switch {
+	case ok && val.IsZero():
+		s.degs = append(s.degs[:i], s.degs[i+1:]...)
+		s.coefs = append(s.coefs[:i], s.coefs[i+1:]...)
+	case ok:
+		s.coefs[i] = val
+	case val.IsNonzero():
+		s.degs = append(s.degs, 0)
+		s.coefs = append(s.coefs, nil)
+		copy(s.degs[i+1:], s.degs[i:])
+		copy(s.coefs[i+1:], s.coefs[i:])
+		s.degs[i], s.coefs[i] = deg, val
+	}
+}
+
+// ld returns the leading degree of s.
+func (s *sparseCoefs) ld() int {
+	if len(s.degs) == 0 {
+		return 0
+	}
+	return s.degs[len(s.degs)-1]
+}
+
+// toSparse converts f to the sparse representation.
+func (f *Polynomial) toSparse() {
+	if f.sparse != nil {
+		return
+	}
+	s := &sparseCoefs{}
+	for deg, c := range f.coefs {
+		if c != nil && c.IsNonzero() {
+			s.degs = append(s.degs, deg)
+			s.coefs = append(s.coefs, c)
+		}
+	}
+	f.sparse = s
+	f.coefs = nil
+}
+
+// toDense converts f to the dense representation.
+func (f *Polynomial) toDense() {
+	if f.sparse == nil {
+		return
+	}
+	coefs := make([]ff.Element, f.sparse.ld()+1)
+	for i, deg := range f.sparse.degs {
+		coefs[deg] = f.sparse.coefs[i]
+	}
+	if coefs[0] == nil {
+		coefs[0] = f.BaseField().Zero()
+	}
+	f.coefs = coefs
+	f.sparse = nil
+}
+
+// adjustSparse converts a sparse polynomial to the dense representation if it
+// is no longer sparse enough. Dense polynomials are not affected.
+func (f *Polynomial) adjustSparse() {
+	if f.sparse == nil {
+		return
+	}
+	ld := f.sparse.ld()
+	if ld < sparseThreshold || len(f.sparse.degs)*sparseRatio > 2*(ld+1) {
+		f.toDense()
+	}
+}
+
+// shouldGrowSparse determines whether f should be converted to the sparse
+// representation before setting the coefficient of degree deg > f.Ld().
+func (f *Polynomial) shouldGrowSparse(deg int) bool {
+	return deg >= sparseThreshold && deg >= sparseRatio*(f.Ld()+1)
+}
+
+// IsSparse returns a boolean describing whether f is currently stored in the
+// sparse representation. The representation is chosen automatically, and does
+// not affect the result of any method.
+func (f *Polynomial) IsSparse() bool {
+	return f.sparse != nil
+}
+
+// forEachTerm calls fn for each non-zero term of f in order of increasing
+// degree. The coefficient is passed as a pointer, and fn must not modify f.
+func (f *Polynomial) forEachTerm(fn func(deg int, c ff.Element)) {
+	if f.sparse != nil {
+		for i, deg := range f.sparse.degs {
+			fn(deg, f.sparse.coefs[i])
+		}
+		return
+	}
+	for deg, c := range f.coefs {
+		if c == nil || c.IsZero() {
+			continue
+		}
+		fn(deg, c)
+	}
+}
+
+// multSparse multiplies f and g term by term. The result is not reduced
+// according to the ring, and it is stored sparsely if possible.
+func (f *Polynomial) multSparse(g *Polynomial) *Polynomial {
+	const op = "Multiplying polynomials"
+
+	terms := make(map[int]ff.Element)
+	overflow := false
+	f.forEachTerm(func(degf int, cf ff.Element) {
+		g.forEachTerm(func(degg int, cg ff.Element) {
+			if degf+degg < degf {
+				overflow = true
+				return
+			}
+			if c, ok := terms[degf+degg]; ok {
+				c.Add(cf.Times(cg))
+			} else {
+				terms[degf+degg] = cf.Times(cg)
+			}
+		})
+	})
+
+	if overflow {
+		h := f.baseRing.Zero()
+		h.err = errors.New(
+			op, errors.Overflow,
+			"Degrees %v + %v overflow int", f.Ld(), g.Ld(),
+		)
+		return h
+	}
+
+	s := &sparseCoefs{
+		degs:  make([]int, 0, len(terms)),
+		coefs: make([]ff.Element, 0, len(terms)),
+	}
+	for deg, c := range terms {
+		if c.IsNonzero() {
+			s.degs = append(s.degs, deg)
+		}
+	}
+	sort.Ints(s.degs)
+	for _, deg := range s.degs {
+		s.coefs = append(s.coefs, terms[deg])
+	}
+
+	h := f.baseRing.Zero()
+	if len(s.degs) > 0 {
+		h.coefs = nil
+		h.sparse = s
+		h.adjustSparse()
+	}
+	return h
+}
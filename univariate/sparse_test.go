@@ -0,0 +1,107 @@
+package univariate_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ReneBoedker/algobra/finitefield/ff"
+	"github.com/ReneBoedker/algobra/univariate"
+)
+
+func TestSparse(t *testing.T) {
+	field := defineField(7)
+	ring := univariate.DefRing(field)
+
+	// X^(7^10) - X would require a dense slice of length 282475250
+	n := 282475249
+	f, err := ring.PolynomialFromString(fmt.Sprintf("X^%d - X", n))
+	if err != nil {
+		t.Fatalf("Failed to parse polynomial: %q", err)
+	}
+	if !f.IsSparse() {
+		t.Errorf("%v is not stored sparsely", f)
+	}
+	if f.NTerms() != 2 || f.Ld() != n || !f.Coef(1).Equal(field.ElementFromSigned(-1)) {
+		t.Errorf("Sparse polynomial %v has unexpected terms", f)
+	}
+	for _, a := range field.Elements() {
+		if f.Eval(a).IsNonzero() {
+			t.Errorf("%v evaluated to %v at %v", f, f.Eval(a), a)
+		}
+	}
+
+	// Frobenius: (X+1)^(7^10) = X^(7^10) + 1 should stay sparse, and modulo f
+	// it equals X + 1
+	g := ring.PolynomialFromUnsigned([]uint{1, 1})
+	h := g.Pow(uint(n))
+	if !h.IsSparse() || h.NTerms() != 2 || h.Ld() != n {
+		t.Errorf("(X+1)^%d was computed as %v", n, h)
+	}
+
+	id, err := ring.NewIdeal(f)
+	if err != nil {
+		t.Fatalf("Failed to define ideal: %q", err)
+	}
+	qRing, err := ring.Quotient(id)
+	if err != nil {
+		t.Fatalf("Failed to define quotient ring: %q", err)
+	}
+	gq := qRing.PolynomialFromUnsigned([]uint{1, 1})
+	if hq := gq.Pow(uint(n)); !hq.Equal(gq) {
+		t.Errorf("(X+1)^%d modulo %v was %v, but expected %v", n, f, hq, gq)
+	}
+	if hq := gq.Pow(uint(n) + 1); hq.String() != "X^2 + 2X + 1" {
+		t.Errorf("(X+1)^%d modulo %v was %v, but expected X^2 + 2X + 1",
+			n+1, f, hq)
+	}
+
+	// Compare sparse arithmetic to evaluations
+	do := func(field ff.Field) {
+		ring := univariate.DefRing(field)
+		randomSparse := func() *univariate.Polynomial {
+			f := ring.Zero()
+			for i := prg.Intn(5) + 1; i > 0; i-- {
+				f.SetCoef(prg.Intn(500), field.RandElement())
+			}
+			return f
+		}
+		for rep := 0; rep < 20; rep++ {
+			f, g := randomSparse(), randomSparse()
+			if g.IsZero() {
+				continue
+			}
+
+			x := field.RandElement()
+			if prod := f.Times(g); !prod.Eval(x).Equal(f.Eval(x).Times(g.Eval(x))) {
+				t.Errorf("Product of %v and %v was %v", f, g, prod)
+			}
+			if sum := f.Plus(g); !sum.Eval(x).Equal(f.Eval(x).Plus(g.Eval(x))) {
+				t.Errorf("Sum of %v and %v was %v", f, g, sum)
+			}
+
+			q, r, err := f.QuoRem(g)
+			if err != nil {
+				t.Errorf("QuoRem returned an error: %q", err)
+				continue
+			}
+			if r.IsNonzero() && r.Ld() >= g.Ld() {
+				t.Errorf("Remainder %v of %v modulo %v has too large degree",
+					r, f, g)
+			}
+			if !q[0].Times(g).Plus(r).Equal(f) {
+				t.Errorf("QuoRem(%v, %v) returned (%v, %v)", f, g, q[0], r)
+			}
+
+			// Filling in the coefficients switches to dense representation
+			dense := f.Copy()
+			for i := 0; i <= f.Ld(); i++ {
+				dense.SetCoef(i, field.One())
+			}
+			if dense.IsSparse() {
+				t.Errorf("Polynomial %v with all coefficients non-zero is "+
+					"stored sparsely", dense)
+			}
+		}
+	}
+	fieldLoop(do)
+}